@@ -0,0 +1,76 @@
+// Command altitude-export generates Terraform configuration, with import blocks, for existing
+// Altitude environments and domains so that they can be brought under management by the provider.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"terraform-provider-altitude/internal/provider"
+	"terraform-provider-altitude/internal/provider/client"
+)
+
+// listFlag collects a flag which can be repeated or given as a comma separated list.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+func main() {
+	var environmentIds, domains listFlag
+	var out string
+
+	flag.Var(&environmentIds, "environment", "an environment ID whose config should be exported, may be repeated")
+	flag.Var(&domains, "domain", "a domain whose domain and rules mappings should be exported, may be repeated")
+	flag.StringVar(&out, "out", "", "the file to write the configuration to, defaults to stdout")
+	flag.Parse()
+
+	if len(environmentIds) == 0 && len(domains) == 0 {
+		fmt.Fprintln(os.Stderr, "at least one -environment or -domain must be given")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	mode := client.Mode(os.Getenv("ALTITUDE_MODE"))
+	if !mode.IsValid() {
+		mode = client.Local
+	}
+
+	c, err := client.New(
+		os.Getenv("ALTITUDE_CLIENT_ID"),
+		os.Getenv("ALTITUDE_CLIENT_SECRET"),
+		mode,
+	)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	hcl, warnings := provider.Export(context.Background(), c, provider.ExportInput{
+		EnvironmentIds: environmentIds,
+		Domains:        domains,
+	})
+	for _, w := range warnings {
+		log.Printf("skipping %s", w.Error())
+	}
+
+	if out == "" {
+		os.Stdout.Write(hcl)
+		return
+	}
+	if err := os.WriteFile(out, hcl, 0o644); err != nil {
+		log.Fatal(err.Error())
+	}
+}
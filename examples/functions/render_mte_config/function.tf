@@ -0,0 +1,12 @@
+output "payload" {
+  value = provider::altitude::render_mte_config(jsonencode({
+    routes = [
+      {
+        host                 = "www.thgaltitude.com"
+        path                 = "/"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+  }))
+}
@@ -0,0 +1,7 @@
+resource "altitude_mte_cache_purge" "purge" {
+  environment_id = "123"
+  paths          = ["/products/**"]
+  triggers = {
+    release = "v1.2.3"
+  }
+}
\ No newline at end of file
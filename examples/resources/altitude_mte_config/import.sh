@@ -0,0 +1 @@
+terraform import altitude_mte_config.example <environment_id>
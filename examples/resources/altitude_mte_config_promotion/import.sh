@@ -0,0 +1 @@
+terraform import altitude_mte_config_promotion.example <source_environment_id>/<target_environment_id>
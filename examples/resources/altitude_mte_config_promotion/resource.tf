@@ -0,0 +1,9 @@
+resource "altitude_mte_config_promotion" "production" {
+  source_environment_id = "uat"
+  target_environment_id = "production"
+  host_substitutions = {
+    "uat.example.com" = "www.example.com"
+  }
+  shield_location   = "London"
+  remove_basic_auth = true
+}
@@ -0,0 +1 @@
+terraform import altitude_mte_domain_mapping.example <domain>
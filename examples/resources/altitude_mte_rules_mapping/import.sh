@@ -0,0 +1 @@
+terraform import altitude_mte_rules_mapping.example <domain>
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-altitude/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MTECachePurgeResource{}
+var _ resource.ResourceWithModifyPlan = &MTECachePurgeResource{}
+var _ resource.ResourceWithValidateConfig = &MTECachePurgeResource{}
+
+func NewMTECachePurgeResource() resource.Resource {
+	return &MTECachePurgeResource{}
+}
+
+type MTECachePurgeResource struct {
+	client   *client.Client
+	defaults ProviderDefaults
+}
+
+type MTECachePurgeResourceModel struct {
+	EnvironmentId types.String            `tfsdk:"environment_id"`
+	Paths         []types.String          `tfsdk:"paths"`
+	SurrogateKeys []types.String          `tfsdk:"surrogate_keys"`
+	PurgeAll      types.Bool              `tfsdk:"purge_all"`
+	Triggers      map[string]types.String `tfsdk:"triggers"`
+	PurgeId       types.String            `tfsdk:"purge_id"`
+	Timeouts      timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// Metadata implements resource.Resource.
+func (m *MTECachePurgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mte_cache_purge"
+}
+
+func (m *MTECachePurgeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	resourceData, ok := req.ProviderData.(*ConfiguredData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ConfiguredData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	m.client = resourceData.client
+	m.defaults = resourceData.defaults
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (m *MTECachePurgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	m.defaults.applyEnvironmentId(ctx, req, resp, true)
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig. A purge of the whole cache must be requested
+// explicitly, so that an empty or missing list is not mistaken for one.
+func (m *MTECachePurgeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var paths, surrogateKeys types.List
+	var purgeAll types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("paths"), &paths)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("surrogate_keys"), &surrogateKeys)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("purge_all"), &purgeAll)...)
+
+	if resp.Diagnostics.HasError() || purgeAll.IsUnknown() {
+		return
+	}
+
+	if paths.IsNull() && surrogateKeys.IsNull() && !purgeAll.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Missing Attribute Configuration",
+			"Expected one of `paths` or `surrogate_keys` to be set, or `purge_all` to be set to `true` to purge the whole cache of the environment.",
+		)
+	}
+}
+
+// Schema implements resource.Resource.
+func (m *MTECachePurgeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource which purges the edge cache of an environment when it is created. Any change to its attributes, " +
+			"such as a new value in `triggers`, will replace this resource and purge the cache again. Destroying this resource does not affect the cache.",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The environment ID whose cache should be purged. Defaults to the provider's `default_environment_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "A list of glob paths to purge.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"surrogate_keys": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "A list of surrogate keys to purge.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"purge_all": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Set to `true` to purge the whole cache of the environment. Cannot be used with `paths` or `surrogate_keys`, " +
+					"one of which must be set otherwise.",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("paths"), path.MatchRoot("surrogate_keys")),
+				},
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "A map of arbitrary values which will purge the cache again when changed. For example, a deployment " +
+					"version or the ID of a config resource.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"purge_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the completed purge.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create implements resource.Resource.
+func (m *MTECachePurgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MTECachePurgeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	purge, err := m.client.PurgeMTECache(
+		ctx,
+		client.PurgeMTECacheInput{
+			Purge:         data.transformToApiRequestBody(),
+			EnvironmentId: data.EnvironmentId.ValueString(),
+		},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to purge MTE cache",
+			"An error occurred while executing the purge. "+
+				"If unexpected, please report this issue to the provider developers.\n\n"+
+				"JSON Error: "+err.Error())
+		return
+	}
+
+	data.PurgeId = types.StringValue(purge.PurgeId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource. A purge is a one-off action, so there is nothing to refresh.
+func (m *MTECachePurgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MTECachePurgeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Every attribute other than `timeouts` requires replacement.
+func (m *MTECachePurgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MTECachePurgeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource. Removing a purge from state does not affect the cache.
+func (m *MTECachePurgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (m *MTECachePurgeResourceModel) transformToApiRequestBody() client.MTECachePurgeDto {
+	var dto client.MTECachePurgeDto
+	if m.Paths != nil {
+		dto.Paths = make([]string, len(m.Paths))
+		for i, p := range m.Paths {
+			dto.Paths[i] = p.ValueString()
+		}
+	}
+	if m.SurrogateKeys != nil {
+		dto.SurrogateKeys = make([]string, len(m.SurrogateKeys))
+		for i, k := range m.SurrogateKeys {
+			dto.SurrogateKeys[i] = k.ValueString()
+		}
+	}
+	return dto
+}
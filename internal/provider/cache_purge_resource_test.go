@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCachePurgeResource(t *testing.T) {
+	var TEST_ENVIRONMENT_ID = randomString(10)
+	var INITIAL_HOST = "www.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCachePurge(TEST_ENVIRONMENT_ID, INITIAL_HOST, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_cache_purge.tester", "environment_id", TEST_ENVIRONMENT_ID),
+					resource.TestCheckResourceAttr("altitude_mte_cache_purge.tester", "triggers.release", "v1"),
+					resource.TestCheckResourceAttrSet("altitude_mte_cache_purge.tester", "purge_id"),
+				),
+			},
+			{
+				Config: testAccCachePurge(TEST_ENVIRONMENT_ID, INITIAL_HOST, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_cache_purge.tester", "triggers.release", "v2"),
+					resource.TestCheckResourceAttrSet("altitude_mte_cache_purge.tester", "purge_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCachePurge(environmentId string, host string, release string) string {
+	return fmt.Sprintf(`
+resource "altitude_mte_config" "tester" {
+  config = {
+    routes = [
+      {
+        host                 = "%s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+  }
+  environment_id = "%s"
+}
+
+resource "altitude_mte_cache_purge" "tester" {
+  environment_id = altitude_mte_config.tester.environment_id
+  paths          = ["/test/**"]
+  triggers = {
+    release = "%s"
+  }
+}
+`, host, environmentId, release)
+}
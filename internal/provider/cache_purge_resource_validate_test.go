@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMTECachePurgeResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &MTECachePurgeResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	listType := tftypes.List{ElementType: tftypes.String}
+
+	tests := map[string]struct {
+		paths       tftypes.Value
+		purgeAll    tftypes.Value
+		expectError bool
+	}{
+		"paths": {
+			paths:    tftypes.NewValue(listType, []tftypes.Value{tftypes.NewValue(tftypes.String, "/products/**")}),
+			purgeAll: tftypes.NewValue(tftypes.Bool, nil),
+		},
+		"purge all": {
+			paths:    tftypes.NewValue(listType, nil),
+			purgeAll: tftypes.NewValue(tftypes.Bool, true),
+		},
+		"nothing selected": {
+			paths:       tftypes.NewValue(listType, nil),
+			purgeAll:    tftypes.NewValue(tftypes.Bool, nil),
+			expectError: true,
+		},
+		"purge all disabled": {
+			paths:       tftypes.NewValue(listType, nil),
+			purgeAll:    tftypes.NewValue(tftypes.Bool, false),
+			expectError: true,
+		},
+		"unknown purge all": {
+			paths:    tftypes.NewValue(listType, nil),
+			purgeAll: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value)
+			for attr, attrType := range objectType.AttributeTypes {
+				values[attr] = tftypes.NewValue(attrType, nil)
+			}
+			values["paths"] = test.paths
+			values["purge_all"] = test.purgeAll
+
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Raw:    tftypes.NewValue(objectType, values),
+					Schema: schemaResp.Schema,
+				},
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error %t, got diagnostics %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cidrValidator{}
+
+// cidrValidator validates that a string is an IPv4 or IPv6 CIDR block with no host bits set.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 or IPv6 CIDR block, such as 192.0.2.0/24 or 2001:db8::/32"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Block",
+			fmt.Sprintf("The value %q is not a valid CIDR block: %s", req.ConfigValue.ValueString(), err),
+		)
+		return
+	}
+
+	if prefix.Masked() != prefix {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Block",
+			fmt.Sprintf("The value %q has host bits set. Did you mean %q?", req.ConfigValue.ValueString(), prefix.Masked().String()),
+		)
+	}
+}
+
+// overlappingCIDRs returns each pair of CIDR blocks, one from a and one from b, which share
+// any addresses. When a and b are the same list, each pair is only reported once. Values
+// which cannot be parsed are ignored as they are reported by cidrValidator.
+func overlappingCIDRs(a []string, b []string, sameList bool) [][2]string {
+	var overlaps [][2]string
+	for i, x := range a {
+		xPrefix, err := netip.ParsePrefix(x)
+		if err != nil {
+			continue
+		}
+		for j, y := range b {
+			if sameList && j <= i {
+				continue
+			}
+			yPrefix, err := netip.ParsePrefix(y)
+			if err != nil {
+				continue
+			}
+			if xPrefix.Overlaps(yPrefix) {
+				overlaps = append(overlaps, [2]string{x, y})
+			}
+		}
+	}
+	return overlaps
+}
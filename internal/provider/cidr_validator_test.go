@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCIDRValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value         string
+		expectedError bool
+	}{
+		"ipv4":           {value: "192.0.2.0/24"},
+		"ipv4-single":    {value: "192.0.2.10/32"},
+		"ipv6":           {value: "2001:db8::/32"},
+		"host-bits-set":  {value: "192.0.2.10/24", expectedError: true},
+		"missing-prefix": {value: "192.0.2.10", expectedError: true},
+		"not-an-address": {value: "foo/24", expectedError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue(testCase.value),
+			}
+			resp := validator.StringResponse{}
+
+			cidrValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectedError {
+				t.Errorf("expected error to be %t, got diagnostics: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOverlappingCIDRs(t *testing.T) {
+	testCases := map[string]struct {
+		a        []string
+		b        []string
+		sameList bool
+		expected [][2]string
+	}{
+		"disjoint": {
+			a: []string{"192.0.2.0/24"},
+			b: []string{"198.51.100.0/24"},
+		},
+		"contained": {
+			a:        []string{"10.0.0.0/8"},
+			b:        []string{"10.1.0.0/16"},
+			expected: [][2]string{{"10.0.0.0/8", "10.1.0.0/16"}},
+		},
+		"mixed-families": {
+			a: []string{"0.0.0.0/0"},
+			b: []string{"::/0"},
+		},
+		"same-list": {
+			a:        []string{"10.0.0.0/8", "192.0.2.0/24", "10.2.0.0/16"},
+			b:        []string{"10.0.0.0/8", "192.0.2.0/24", "10.2.0.0/16"},
+			sameList: true,
+			expected: [][2]string{{"10.0.0.0/8", "10.2.0.0/16"}},
+		},
+		"invalid-ignored": {
+			a: []string{"foo"},
+			b: []string{"10.0.0.0/8"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			overlaps := overlappingCIDRs(testCase.a, testCase.b, testCase.sameList)
+			if !slices.Equal(overlaps, testCase.expected) {
+				t.Errorf("expected overlaps %v, got %v", testCase.expected, overlaps)
+			}
+		})
+	}
+}
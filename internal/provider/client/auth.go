@@ -0,0 +1,243 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Authenticator obtains the bearer tokens sent with requests to the Altitude API.
+type Authenticator interface {
+	fetchToken(ctx context.Context, c *Client) (authToken, error)
+}
+
+type authToken struct {
+	value string
+	// expiry is when the token should be replaced, and is zero for tokens which are never refreshed.
+	expiry time.Time
+}
+
+// tokenExpiryMargin is how long before expiry a token is refreshed, so that it does not expire in flight.
+const tokenExpiryMargin = time.Minute
+
+// tokenFileRefreshInterval is how often a token file is read again when the token does not say when it expires.
+const tokenFileRefreshInterval = 5 * time.Minute
+
+func (t authToken) isValid(now time.Time) bool {
+	return t.value != "" && (t.expiry.IsZero() || now.Add(tokenExpiryMargin).Before(t.expiry))
+}
+
+// bearerToken returns the current token, fetching a new one if it is near expiry or refresh is set.
+func (c *Client) bearerToken(ctx context.Context, refresh bool) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if !refresh && c.token.isValid(time.Now()) {
+		return c.token.value, nil
+	}
+
+	token, err := c.auth.fetchToken(ctx, c)
+	if err != nil {
+		return "", err
+	}
+	c.token = token
+	return token.value, nil
+}
+
+// ClientCredentials authenticates with the OAuth client credentials grant using a client ID and secret.
+type ClientCredentials struct {
+	ClientId     string
+	ClientSecret string
+}
+
+func (a ClientCredentials) fetchToken(ctx context.Context, c *Client) (authToken, error) {
+	return c.requestToken(ctx, AuthDto{
+		Audience:     c.clientVariables.audience,
+		ClientId:     a.ClientId,
+		ClientSecret: a.ClientSecret,
+		GrantType:    "client_credentials",
+	})
+}
+
+// AccessToken authenticates with a token obtained outside of the provider, which cannot be refreshed.
+type AccessToken struct {
+	Token string
+}
+
+func (a AccessToken) fetchToken(ctx context.Context, c *Client) (authToken, error) {
+	return authToken{value: a.Token}, nil
+}
+
+// AccessTokenFile authenticates with a token read from a file. The file is read again when the token nears
+// expiry or is rejected, so that it can be rotated by another process such as a CI runner.
+type AccessTokenFile struct {
+	Path string
+}
+
+func (a AccessTokenFile) fetchToken(ctx context.Context, c *Client) (authToken, error) {
+	token, err := readTokenFile(a.Path)
+	if err != nil {
+		return authToken{}, err
+	}
+
+	expiry := jwtExpiry(token)
+	if expiry.IsZero() {
+		expiry = time.Now().Add(tokenFileRefreshInterval + tokenExpiryMargin)
+	}
+	return authToken{value: token, expiry: expiry}, nil
+}
+
+// IdentityTokenGrant is the OAuth grant used to exchange an identity token for an access token.
+type IdentityTokenGrant string
+
+const (
+	JWTBearerGrant     IdentityTokenGrant = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	TokenExchangeGrant IdentityTokenGrant = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+// IdentityToken exchanges an identity token issued to a workload, such as the OIDC token of a CI job, for an
+// access token. The file is read on every exchange so that rotated identity tokens are picked up.
+type IdentityToken struct {
+	Path     string
+	ClientId string
+	Grant    IdentityTokenGrant
+}
+
+func (a IdentityToken) fetchToken(ctx context.Context, c *Client) (authToken, error) {
+	token, err := readTokenFile(a.Path)
+	if err != nil {
+		return authToken{}, err
+	}
+
+	authDto := AuthDto{
+		Audience:  c.clientVariables.audience,
+		ClientId:  a.ClientId,
+		GrantType: string(a.Grant),
+	}
+	switch a.Grant {
+	case JWTBearerGrant:
+		authDto.Assertion = token
+	case TokenExchangeGrant:
+		authDto.SubjectToken = token
+		authDto.SubjectTokenType = "urn:ietf:params:oauth:token-type:jwt"
+	default:
+		return authToken{}, fmt.Errorf("unsupported identity token grant %q", a.Grant)
+	}
+	return c.requestToken(ctx, authDto)
+}
+
+func readTokenFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("the token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// jwtExpiry returns the expiry of a JWT without verifying it, or zero if token is not a JWT with an expiry.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+type AuthDto struct {
+	Audience         string `json:"audience"`
+	GrantType        string `json:"grant_type"`
+	ClientId         string `json:"client_id,omitempty"`
+	ClientSecret     string `json:"client_secret,omitempty"`
+	Assertion        string `json:"assertion,omitempty"`
+	SubjectToken     string `json:"subject_token,omitempty"`
+	SubjectTokenType string `json:"subject_token_type,omitempty"`
+}
+
+type AuthResBody struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// requestToken requests an access token from the issuer's token endpoint.
+func (c *Client) requestToken(
+	ctx context.Context,
+	authDto AuthDto,
+) (authToken, error) {
+	reqBody, err := json.Marshal(authDto)
+	if err != nil {
+		return authToken{}, err
+	}
+
+	if c.httpClient == nil {
+		return authToken{}, &AltitudeClientError{
+			shortMessage: "Unexpected API Response",
+			detail:       "Default HTTP Client is undefined",
+		}
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/oauth/token", c.clientVariables.issuer),
+		bytes.NewBuffer(reqBody),
+	)
+	if err != nil {
+		return authToken{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return authToken{}, err
+	}
+
+	if resp.StatusCode != 200 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return authToken{}, &AltitudeClientError{
+			shortMessage: "Unexpected API Response",
+			detail:       fmt.Sprintf("The Altitude API Request returned a non-200 response of %s with body %s.", resp.Status, body),
+		}
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return authToken{}, err
+	}
+
+	var body AuthResBody
+	err = json.Unmarshal(respBody, &body)
+	if err != nil {
+		return authToken{}, err
+	}
+
+	token := authToken{value: body.AccessToken}
+	if body.ExpiresIn > 0 {
+		token.expiry = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
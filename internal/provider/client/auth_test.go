@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJwtExpiry(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"ci","exp":1700000000}`))
+
+	testCases := map[string]struct {
+		token    string
+		expected time.Time
+	}{
+		"jwt":            {token: "header." + payload + ".signature", expected: time.Unix(1700000000, 0)},
+		"opaque":         {token: "opaque-token"},
+		"invalid-base64": {token: "header.!!!.signature"},
+		"no-expiry":      {token: "header." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"ci"}`)) + ".signature"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := jwtExpiry(tc.token); !got.Equal(tc.expected) {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestIdentityTokenFetchToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "oidc")
+	if err := os.WriteFile(tokenFile, []byte("identity-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		grant    IdentityTokenGrant
+		expected AuthDto
+	}{
+		"jwt-bearer": {
+			grant: JWTBearerGrant,
+			expected: AuthDto{
+				Audience:  "https://api.example.com/",
+				GrantType: string(JWTBearerGrant),
+				ClientId:  "id",
+				Assertion: "identity-token",
+			},
+		},
+		"token-exchange": {
+			grant: TokenExchangeGrant,
+			expected: AuthDto{
+				Audience:         "https://api.example.com/",
+				GrantType:        string(TokenExchangeGrant),
+				ClientId:         "id",
+				SubjectToken:     "identity-token",
+				SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var received AuthDto
+			issuer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&received)
+				_, _ = w.Write([]byte(`{"access_token":"access-token","expires_in":3600}`))
+			}))
+			defer issuer.Close()
+
+			c := &Client{
+				clientVariables: AltitudeClientVariables{audience: "https://api.example.com/", issuer: issuer.URL},
+				httpClient:      issuer.Client(),
+			}
+			token, err := IdentityToken{Path: tokenFile, ClientId: "id", Grant: tc.grant}.fetchToken(context.Background(), c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if received != tc.expected {
+				t.Errorf("expected exchange %+v, got %+v", tc.expected, received)
+			}
+			if token.value != "access-token" || !token.isValid(time.Now()) {
+				t.Errorf("unexpected token %+v", token)
+			}
+		})
+	}
+}
+
+func TestSendRequestRefreshesRejectedToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("old-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := &Client{
+		clientVariables: AltitudeClientVariables{baseUrl: server.URL},
+		httpClient:      server.Client(),
+		auth:            AccessTokenFile{Path: tokenFile},
+	}
+	if _, err := c.bearerToken(context.Background(), false); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(tokenFile, []byte("new-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	httpRes, err := c.initiateRequest(context.Background(), http.MethodPost, "/v2/environment/test/mte/altitude-config", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != http.StatusCreated {
+		t.Errorf("expected the request to be retried with the rotated token, got %s", httpRes.Status)
+	}
+}
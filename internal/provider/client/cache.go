@@ -0,0 +1,52 @@
+package client
+
+import "sync"
+
+// responseCache holds responses from list endpoints for the lifetime of the client, which is a single
+// provider run, so that data sources declared many times cost a single request. Any write made through
+// the client clears the cache so that later reads observe it.
+type responseCache struct {
+	mu         sync.Mutex
+	generation uint64
+	responses  map[string]*bufferedResponse
+}
+
+func (r *responseCache) get(path string) (*bufferedResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.responses[path]
+	return cached, ok
+}
+
+// currentGeneration identifies the writes made so far, and is taken before sending a request
+// whose response is to be cached.
+func (r *responseCache) currentGeneration() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.generation
+}
+
+// put caches a response unless a write has been made since generation was taken, in which case
+// the response may predate the write.
+func (r *responseCache) put(path string, response *bufferedResponse, generation uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if generation != r.generation {
+		return
+	}
+	if r.responses == nil {
+		r.responses = make(map[string]*bufferedResponse)
+	}
+	r.responses[path] = response
+}
+
+func (r *responseCache) clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	r.responses = nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestInitiateCachedRequest(t *testing.T) {
+	var reads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			reads.Add(1)
+			_, _ = w.Write([]byte(`{"endpoints":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := &Client{
+		clientVariables: AltitudeClientVariables{baseUrl: server.URL},
+		httpClient:      server.Client(),
+		auth:            AccessToken{Token: "test"},
+	}
+
+	read := func() {
+		t.Helper()
+		httpRes, err := c.initiateCachedRequest(context.Background(), "/v1/admin/logging")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer httpRes.Body.Close()
+		if body, _ := io.ReadAll(httpRes.Body); string(body) != `{"endpoints":[]}` {
+			t.Errorf("unexpected body %q", body)
+		}
+	}
+
+	read()
+	read()
+	if n := reads.Load(); n != 1 {
+		t.Errorf("expected repeated reads to be served from the cache, got %d requests", n)
+	}
+
+	httpRes, err := c.initiateRequest(context.Background(), http.MethodPut, "/v2/environment/test/mte/altitude-config", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpRes.Body.Close()
+
+	read()
+	if n := reads.Load(); n != 2 {
+		t.Errorf("expected a write to invalidate the cache, got %d requests", n)
+	}
+}
+
+func TestResponseCachePutAfterWrite(t *testing.T) {
+	var cache responseCache
+
+	generation := cache.currentGeneration()
+	cache.clear()
+	cache.put("/v1/admin/logging", &bufferedResponse{}, generation)
+
+	if _, ok := cache.get("/v1/admin/logging"); ok {
+		t.Error("expected a response read before a write not to be cached")
+	}
+}
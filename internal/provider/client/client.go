@@ -2,11 +2,15 @@ package client
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
 )
 
 type Mode string
@@ -30,15 +34,34 @@ type AltitudeClientVariables struct {
 type Client struct {
 	clientVariables AltitudeClientVariables
 	httpClient      *http.Client
-	token           string
+	auth            Authenticator
+	tokenMu         sync.Mutex
+	token           authToken
+	limiter         requestLimiter
+	// requests coalesces identical GET requests made concurrently, such as by many data sources
+	// reading the same list, into a single request to the Altitude API.
+	requests singleflight.Group
+	cache    responseCache
 }
 
+// New creates a client which authenticates with the OAuth client credentials grant.
 func New(
 	clientId string,
 	clientSecret string,
-	mode Mode) (*Client, error) {
+	mode Mode,
+	opts ...Option) (*Client, error) {
+	return NewWithAuthenticator(ClientCredentials{ClientId: clientId, ClientSecret: clientSecret}, mode, opts...)
+}
+
+// NewWithAuthenticator creates a client which authenticates using auth. A token is obtained
+// immediately so that misconfigured credentials are reported before any request is made.
+func NewWithAuthenticator(
+	auth Authenticator,
+	mode Mode,
+	opts ...Option) (*Client, error) {
 	c := new(Client)
 	c.httpClient = http.DefaultClient
+	c.auth = auth
 	switch mode {
 	case Production:
 		c.clientVariables = AltitudeClientVariables{
@@ -59,7 +82,10 @@ func New(
 			issuer:   "https://dev-thgaltitude.eu.auth0.com",
 		}
 	}
-	err := c.generateAuthToken(clientId, clientSecret)
+	for _, opt := range opts {
+		opt(c)
+	}
+	_, err := c.bearerToken(context.Background(), false)
 	if err != nil {
 		return nil, &AltitudeClientError{
 			"The Altitude Client is unable to generate an auth token",
@@ -69,23 +95,96 @@ func New(
 	return c, nil
 }
 
-func (c *Client) addAuthenticationToRequest(req *http.Request) {
-	bearer := "Bearer " + c.token
-	req.Header.Add("Authorization", bearer)
+func (c *Client) addAuthenticationToRequest(req *http.Request, refresh bool) error {
+	token, err := c.bearerToken(req.Context(), refresh)
+	if err != nil {
+		return &AltitudeClientError{
+			shortMessage: "Authentication Error",
+			detail:       fmt.Sprintf("Unable to obtain an auth token, received error: %s", err),
+		}
+	}
+	bearer := "Bearer " + token
+	req.Header.Set("Authorization", bearer)
+	return nil
 }
 
 func (c *Client) initiateRequest(
+	ctx context.Context,
 	method string,
 	path string,
 	body io.Reader,
 ) (*http.Response, error) {
+	return c.initiateConditionalRequest(ctx, method, path, body, "")
+}
+
+// initiateConditionalRequest sends a request which only succeeds if the resource still matches
+// etag. The condition is omitted when etag is empty.
+func (c *Client) initiateConditionalRequest(
+	ctx context.Context,
+	method string,
+	path string,
+	body io.Reader,
+	etag string,
+) (*http.Response, error) {
+	httpReq, err := c.newRequest(ctx, method, path, body, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	if method == http.MethodGet {
+		shared, err := c.coalesceRequest(httpReq)
+		if err != nil {
+			return nil, err
+		}
+		return shared.newResponse(), nil
+	}
+
+	httpRes, err := c.sendRequest(httpReq)
+	c.cache.clear()
+	return httpRes, err
+}
+
+// initiateCachedRequest sends a GET request to a list endpoint, reusing the response to an earlier
+// request for the same path unless a write has been made since.
+func (c *Client) initiateCachedRequest(
+	ctx context.Context,
+	path string,
+) (*http.Response, error) {
+	if cached, ok := c.cache.get(path); ok {
+		return cached.newResponse(), nil
+	}
+
+	generation := c.cache.currentGeneration()
+	httpReq, err := c.newRequest(ctx, http.MethodGet, path, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	shared, err := c.coalesceRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if shared.response.StatusCode == http.StatusOK {
+		c.cache.put(path, shared, generation)
+	}
+	return shared.newResponse(), nil
+}
+
+func (c *Client) newRequest(
+	ctx context.Context,
+	method string,
+	path string,
+	body io.Reader,
+	etag string,
+) (*http.Request, error) {
 	if !strings.HasPrefix(path, "/") {
 		return nil, &AltitudeClientError{
 			shortMessage: "Incorrect Path Format",
 			detail:       fmt.Sprintf("The path %s should be specified with a prefixed slash.", path),
 		}
 	}
-	httpReq, err := http.NewRequest(
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
 		method,
 		fmt.Sprintf("%s%s", c.clientVariables.baseUrl, path),
 		body,
@@ -99,76 +198,94 @@ func (c *Client) initiateRequest(
 	if method == http.MethodPost || method == http.MethodPut || method == http.MethodGet {
 		httpReq.Header.Set("Content-Type", "application/json")
 	}
-	c.addAuthenticationToRequest(httpReq)
-	return c.httpClient.Do(httpReq)
-}
-
-type AuthDto struct {
-	Audience     string `json:"audience"`
-	GrantType    string `json:"grant_type"`
-	ClientId     string `json:"client_id"`
-	ClientSecret string `json:"client_secret"`
-}
-
-type AuthResBody struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-	ExpiresIn   int    `json:"expires_in"`
-}
-
-func (c *Client) generateAuthToken(
-	clientId string,
-	clientSecret string,
-) error {
-	authDto := AuthDto{
-		Audience:     c.clientVariables.audience,
-		ClientId:     clientId,
-		ClientSecret: clientSecret,
-		GrantType:    "client_credentials",
+	if etag != "" {
+		httpReq.Header.Set("If-Match", etag)
 	}
+	return httpReq, nil
+}
 
-	reqBody, err := json.Marshal(authDto)
+// sendRequest sends a request once the request limits allow it. If the token is rejected, such as
+// when it has been revoked or rotated early, it is refreshed and the request is retried once.
+func (c *Client) sendRequest(httpReq *http.Request) (*http.Response, error) {
+	release, err := c.limiter.acquire(httpReq.Context())
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer release()
 
-	if c.httpClient == nil {
-		return &AltitudeClientError{
-			shortMessage: "Unexpected API Response",
-			detail:       "Default HTTP Client is undefined",
-		}
+	if err := c.addAuthenticationToRequest(httpReq, false); err != nil {
+		return nil, err
 	}
-
-	resp, err := c.httpClient.Post(
-		fmt.Sprintf("%s/oauth/token", c.clientVariables.issuer),
-		"application/json",
-		bytes.NewBuffer(reqBody),
-	)
-	if err != nil {
-		return err
+	httpRes, err := c.httpClient.Do(httpReq)
+	if err != nil || httpRes.StatusCode != http.StatusUnauthorized || (httpReq.Body != nil && httpReq.GetBody == nil) {
+		return httpRes, err
 	}
+	httpRes.Body.Close()
 
-	if resp.StatusCode != 200 {
-		defer resp.Body.Close()
-		body, _ := io.ReadAll(resp.Body)
-		return &AltitudeClientError{
-			shortMessage: "Unexpected API Response",
-			detail:       fmt.Sprintf("The Altitude API Request returned a non-200 response of %s with body %s.", resp.Status, body),
+	retryReq := httpReq.Clone(httpReq.Context())
+	if httpReq.GetBody != nil {
+		if retryReq.Body, err = httpReq.GetBody(); err != nil {
+			return nil, err
 		}
 	}
-
-	defer resp.Body.Close()
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
+	if err := c.addAuthenticationToRequest(retryReq, true); err != nil {
+		return nil, err
 	}
+	return c.httpClient.Do(retryReq)
+}
 
-	var body AuthResBody
-	err = json.Unmarshal(respBody, &body)
-	if err != nil {
-		return err
+// bufferedResponse is a response whose body has been read so that it can be shared between callers.
+type bufferedResponse struct {
+	response *http.Response
+	body     []byte
+}
+
+// newResponse returns a copy of the response with its own body reader.
+func (b *bufferedResponse) newResponse() *http.Response {
+	httpRes := *b.response
+	httpRes.Header = b.response.Header.Clone()
+	httpRes.Body = io.NopCloser(bytes.NewReader(b.body))
+	return &httpRes
+}
+
+// coalesceRequest sends a request, sharing the response with any identical requests made while it is in flight.
+// The shared request is not cancelled with the context of the caller which started it, so that one resource with
+// a short timeout does not fail the others waiting on it. Each caller stops waiting when its own context is done.
+func (c *Client) coalesceRequest(httpReq *http.Request) (*bufferedResponse, error) {
+	ctx := httpReq.Context()
+	key := httpReq.Method + " " + httpReq.URL.String()
+	for retried := false; ; retried = true {
+		results := c.requests.DoChan(key, func() (any, error) {
+			httpRes, err := c.sendRequest(httpReq.Clone(context.WithoutCancel(ctx)))
+			if err != nil {
+				return nil, err
+			}
+			defer httpRes.Body.Close()
+
+			body, err := io.ReadAll(httpRes.Body)
+			if err != nil {
+				return nil, err
+			}
+			return &bufferedResponse{httpRes, body}, nil
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case result := <-results:
+			if result.Err != nil {
+				// A context error from a request shared with another caller is not ours to return while our
+				// own context is still alive, so the request is made again.
+				if isContextError(result.Err) && result.Shared && ctx.Err() == nil && !retried {
+					continue
+				}
+				return nil, result.Err
+			}
+			return result.Val.(*bufferedResponse), nil
+		}
 	}
+}
 
-	c.token = body.AccessToken
-	return nil
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
 }
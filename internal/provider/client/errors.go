@@ -11,4 +11,22 @@ func (e *AltitudeClientError) Error() string {
 	return fmt.Sprintf("%s\n%s", e.shortMessage, e.detail)
 }
 
+// ConflictError is returned when the Altitude API rejects a creation because
+// the resource already exists.
+type ConflictError struct {
+	AltitudeClientError
+}
+
+// NotFoundError is returned when the Altitude API has no resource matching
+// the request.
+type NotFoundError struct {
+	AltitudeClientError
+}
+
+// PreconditionFailedError is returned when the Altitude API rejects a write because
+// the resource has changed since the version given in If-Match was read.
+type PreconditionFailedError struct {
+	AltitudeClientError
+}
+
 type InternalServerError struct{}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// requestLimiter bounds the number of requests in flight and the rate at which they are sent.
+// Either limit is disabled when nil.
+type requestLimiter struct {
+	inFlight *semaphore.Weighted
+	bucket   *tokenBucket
+}
+
+// acquire blocks until a request may be sent, returning a function which must be called once it has completed.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.inFlight != nil {
+		if err := l.inFlight.Acquire(ctx, 1); err != nil {
+			return nil, err
+		}
+	}
+	release := func() {
+		if l.inFlight != nil {
+			l.inFlight.Release(1)
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+// tokenBucket is a token bucket rate limiter. Tokens are added at rate per second up to burst,
+// and each request takes one.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64, burst float64) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token, returning how long the caller must wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// cancel returns a token reserved by a request which was abandoned before it was sent.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucket := newTokenBucket(2, 2)
+	bucket.last = now
+	bucket.now = func() time.Time { return now }
+
+	expected := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i, e := range expected {
+		if got := bucket.reserve(); got != e {
+			t.Errorf("reservation %d: expected delay %s, got %s", i, e, got)
+		}
+	}
+
+	now = now.Add(10 * time.Second)
+	if got := bucket.reserve(); got != 0 {
+		t.Errorf("expected the bucket to refill after a quiet period, got delay %s", got)
+	}
+}
+
+func TestTokenBucketWaitCancelled(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucket := newTokenBucket(1, 1)
+	bucket.last = now
+	bucket.now = func() time.Time { return now }
+
+	if got := bucket.reserve(); got != 0 {
+		t.Fatalf("expected the first token to be available, got delay %s", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bucket.wait(ctx); err == nil {
+		t.Fatal("expected waiting with a cancelled context to fail")
+	}
+
+	if got := bucket.reserve(); got != time.Second {
+		t.Errorf("expected the cancelled request's token to be returned, got delay %s", got)
+	}
+}
+
+func TestRequestLimiterMaxConcurrentRequests(t *testing.T) {
+	c := &Client{}
+	WithMaxConcurrentRequests(1)(c)
+
+	release, err := c.limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.limiter.acquire(ctx); err == nil {
+		t.Fatal("expected a second request to wait for the first to complete")
+	}
+
+	release()
+	if _, err := c.limiter.acquire(context.Background()); err != nil {
+		t.Fatalf("expected a request to be allowed after release, got %s", err)
+	}
+}
+
+func TestCoalesceRequest(t *testing.T) {
+	t.Run("concurrent requests share one response", func(t *testing.T) {
+		var requests atomic.Int32
+		received := make(chan struct{})
+		respond := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) == 1 {
+				close(received)
+			}
+			<-respond
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(`{"endpoints":[]}`))
+		}))
+		defer server.Close()
+
+		c := &Client{
+			clientVariables: AltitudeClientVariables{baseUrl: server.URL},
+			httpClient:      server.Client(),
+			auth:            AccessToken{Token: "test"},
+		}
+
+		const callers = 5
+		bodies := make([]string, callers)
+		var wg sync.WaitGroup
+		get := func(i int) {
+			defer wg.Done()
+			httpRes, err := c.initiateRequest(context.Background(), http.MethodGet, "/v2/mte/logging-endpoints", nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer httpRes.Body.Close()
+			body, _ := io.ReadAll(httpRes.Body)
+			bodies[i] = string(body) + " " + httpRes.Header.Get("ETag")
+		}
+
+		wg.Add(callers)
+		go get(0)
+		<-received
+		for i := 1; i < callers; i++ {
+			go get(i)
+		}
+		time.Sleep(100 * time.Millisecond)
+		close(respond)
+		wg.Wait()
+
+		if n := requests.Load(); n != 1 {
+			t.Errorf("expected concurrent GETs to be coalesced into 1 request, got %d", n)
+		}
+		for i, b := range bodies {
+			if b != `{"endpoints":[]} "v1"` {
+				t.Errorf("caller %d: unexpected response %q", i, b)
+			}
+		}
+	})
+
+	t.Run("waiters are not failed by the leader's deadline", func(t *testing.T) {
+		var requests atomic.Int32
+		received := make(chan struct{})
+		respond := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) == 1 {
+				close(received)
+			}
+			<-respond
+			_, _ = w.Write([]byte(`{"endpoints":[]}`))
+		}))
+		defer server.Close()
+
+		c := &Client{
+			clientVariables: AltitudeClientVariables{baseUrl: server.URL},
+			httpClient:      server.Client(),
+			auth:            AccessToken{Token: "test"},
+		}
+
+		leaderCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		leaderErr := make(chan error)
+		go func() {
+			_, err := c.initiateRequest(leaderCtx, http.MethodGet, "/v2/mte/logging-endpoints", nil)
+			leaderErr <- err
+		}()
+		<-received
+
+		waiterCtx, cancelWaiter := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelWaiter()
+		waiterBody := make(chan string)
+		go func() {
+			httpRes, err := c.initiateRequest(waiterCtx, http.MethodGet, "/v2/mte/logging-endpoints", nil)
+			if err != nil {
+				t.Error(err)
+				waiterBody <- ""
+				return
+			}
+			defer httpRes.Body.Close()
+			body, _ := io.ReadAll(httpRes.Body)
+			waiterBody <- string(body)
+		}()
+
+		if err := <-leaderErr; !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected the leader to fail with its own deadline, got %v", err)
+		}
+		close(respond)
+
+		if body := <-waiterBody; body != `{"endpoints":[]}` {
+			t.Errorf("expected the waiter to receive the shared response, got %q", body)
+		}
+		if n := requests.Load(); n != 1 {
+			t.Errorf("expected the shared request to continue after the leader's deadline, got %d requests", n)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const cachePurgePollInterval = 2 * time.Second
+
+type PurgeMTECacheInput struct {
+	Purge         MTECachePurgeDto
+	EnvironmentId string
+}
+
+// PurgeMTECache requests a purge of the edge cache for an environment and waits until the
+// purge has completed, or the context is done. An empty purge clears the whole environment.
+func (c *Client) PurgeMTECache(
+	ctx context.Context,
+	input PurgeMTECacheInput,
+) (*MTECachePurgeStatusDto, error) {
+	jsonBody, err := json.Marshal(input.Purge)
+	if err != nil {
+		return nil, &AltitudeClientError{
+			"Input Error.",
+			"Input unable to be JSON encoded.",
+		}
+	}
+
+	httpRes, err := c.initiateRequest(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/v2/environment/%s/mte/cache/purge", input.EnvironmentId),
+		bytes.NewBuffer(jsonBody))
+
+	if err != nil {
+		return nil, &AltitudeClientError{
+			shortMessage: "HTTP Error",
+			detail:       fmt.Sprintf("There has been an error with the http request, received error: %s", err),
+		}
+	}
+
+	if httpRes.StatusCode == 404 {
+		return nil, &AltitudeClientError{
+			shortMessage: "Environment ID not found",
+			detail:       fmt.Sprintf("The Environment %s does not have associated config.", input.EnvironmentId),
+		}
+	}
+
+	if httpRes.StatusCode != 202 {
+		defer httpRes.Body.Close()
+		body, _ := io.ReadAll(httpRes.Body)
+		return nil, &AltitudeClientError{
+			shortMessage: "Unexpected API Response",
+			detail:       fmt.Sprintf("The Altitude API Request returned a non-202 response of %s with body %s.", httpRes.Status, body),
+		}
+	}
+
+	defer httpRes.Body.Close()
+	body, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		return nil, &AltitudeClientError{
+			shortMessage: "Body Read Error",
+			detail:       "Unable to read response body",
+		}
+	}
+
+	var purge MTECachePurgeStatusDto
+	err = json.Unmarshal(body, &purge)
+
+	if err != nil {
+		return nil, &AltitudeClientError{
+			shortMessage: "Body Read Error",
+			detail:       "Unable to parse JSON body from Altitude response",
+		}
+	}
+
+	for purge.Status != PurgeComplete {
+		switch purge.Status {
+		case PurgePending:
+			// The purge is still in progress, so its status is polled again.
+		case PurgeFailed:
+			return nil, &AltitudeClientError{
+				shortMessage: "Cache Purge Failed",
+				detail:       fmt.Sprintf("The cache purge %s for the Environment %s failed.", purge.PurgeId, input.EnvironmentId),
+			}
+		default:
+			return nil, &AltitudeClientError{
+				shortMessage: "Unexpected Cache Purge Status",
+				detail:       fmt.Sprintf("The cache purge %s for the Environment %s returned the unrecognised status %q.", purge.PurgeId, input.EnvironmentId, purge.Status),
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, &AltitudeClientError{
+				shortMessage: "Cache Purge Timeout",
+				detail:       fmt.Sprintf("The cache purge %s for the Environment %s did not complete in time: %s", purge.PurgeId, input.EnvironmentId, ctx.Err()),
+			}
+		case <-time.After(cachePurgePollInterval):
+		}
+
+		status, err := c.ReadMTECachePurge(
+			ctx,
+			ReadMTECachePurgeInput{
+				EnvironmentId: input.EnvironmentId,
+				PurgeId:       purge.PurgeId,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		purge = *status
+	}
+
+	return &purge, nil
+}
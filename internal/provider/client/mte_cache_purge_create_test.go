@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPurgeMTECacheUnrecognisedStatus(t *testing.T) {
+	for _, status := range []string{"", "Cancelled"} {
+		t.Run(status, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"purgeId":"purge-1","status":"` + status + `"}`))
+			}))
+			defer server.Close()
+
+			c := &Client{
+				clientVariables: AltitudeClientVariables{baseUrl: server.URL},
+				httpClient:      server.Client(),
+				auth:            AccessToken{Token: "test"},
+			}
+
+			_, err := c.PurgeMTECache(context.Background(), PurgeMTECacheInput{EnvironmentId: "env"})
+			var clientErr *AltitudeClientError
+			if !errors.As(err, &clientErr) {
+				t.Fatalf("expected an AltitudeClientError, got %v", err)
+			}
+			if !strings.Contains(clientErr.Error(), `"`+status+`"`) {
+				t.Errorf("expected the error to name the status %q, got %s", status, clientErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package client
+
+type MTECachePurgeDto struct {
+	Paths         []string `json:"paths,omitempty"`
+	SurrogateKeys []string `json:"surrogateKeys,omitempty"`
+}
+
+type MTECachePurgeStatusDto struct {
+	PurgeId string           `json:"purgeId"`
+	Status  CachePurgeStatus `json:"status"`
+}
+
+type CachePurgeStatus string
+
+const (
+	PurgePending  CachePurgeStatus = "Pending"
+	PurgeComplete CachePurgeStatus = "Complete"
+	PurgeFailed   CachePurgeStatus = "Failed"
+)
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,30 +15,32 @@ type CreateMTEConfigInput struct {
 }
 
 func (c *Client) CreateMTEConfig(
+	ctx context.Context,
 	input CreateMTEConfigInput,
-) error {
+) (string, error) {
 	jsonBody, err := json.Marshal(input.Config)
 	if err != nil {
-		return &AltitudeClientError{
+		return "", &AltitudeClientError{
 			"Input Error.",
 			"Input unable to be JSON encoded.",
 		}
 	}
 
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf("/v2/environment/%s/mte/altitude-config", input.EnvironmentId),
 		bytes.NewBuffer(jsonBody))
 
 	if err != nil {
-		return &AltitudeClientError{
+		return "", &AltitudeClientError{
 			shortMessage: "HTTP Error",
 			detail:       fmt.Sprintf("There has been an error with the http request, received error: %s", err),
 		}
 	}
 
 	if httpRes.StatusCode == 409 {
-		return &AltitudeClientError{
+		return "", &AltitudeClientError{
 			shortMessage: "Environment ID Conflict",
 			detail:       "This environment already has an associated config block.",
 		}
@@ -46,10 +49,10 @@ func (c *Client) CreateMTEConfig(
 	if httpRes.StatusCode != 201 {
 		defer httpRes.Body.Close()
 		body, _ := io.ReadAll(httpRes.Body)
-		return &AltitudeClientError{
+		return "", &AltitudeClientError{
 			shortMessage: "Unexpected API Response",
 			detail:       fmt.Sprintf("The Altitude API Request returned a non-201 response of %s with body:\n%s", httpRes.Status, body),
 		}
 	}
-	return nil
+	return httpRes.Header.Get("ETag"), nil
 }
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -8,15 +9,20 @@ import (
 
 type DeleteMTEConfigInput struct {
 	EnvironmentId string
+	// ETag, when set, is sent as If-Match so the deletion fails if the config has changed since it was read.
+	ETag string
 }
 
 func (c *Client) DeleteMTEConfig(
+	ctx context.Context,
 	input DeleteMTEConfigInput,
 ) error {
-	httpRes, err := c.initiateRequest(
+	httpRes, err := c.initiateConditionalRequest(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf("/v2/environment/%s/mte/altitude-config", input.EnvironmentId),
-		nil)
+		nil,
+		input.ETag)
 
 	if err != nil {
 		return &AltitudeClientError{
@@ -31,6 +37,15 @@ func (c *Client) DeleteMTEConfig(
 		}
 	}
 
+	if httpRes.StatusCode == 412 {
+		return &PreconditionFailedError{
+			AltitudeClientError{
+				shortMessage: "MTE Config Modified",
+				detail:       fmt.Sprintf("The config of environment %s has been changed since it was last read.", input.EnvironmentId),
+			},
+		}
+	}
+
 	if httpRes.StatusCode != 204 {
 		defer httpRes.Body.Close()
 		body, _ := io.ReadAll(httpRes.Body)
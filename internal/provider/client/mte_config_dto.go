@@ -3,8 +3,14 @@ package client
 type MTEConfigDto struct {
 	Routes             []RouteDto             `json:"routes"`
 	BasicAuth          *BasicAuthDto          `json:"basicAuth,omitempty"`
+	AccessControl      *AccessControlDto      `json:"accessControl,omitempty"`
 	Cache              []CacheDto             `json:"cache,omitempty"`
 	ConditionalHeaders []ConditionalHeaderDto `json:"conditionalHeaders,omitempty"`
+	Headers            []HeaderRuleDto        `json:"headers,omitempty"`
+	Tags               map[string]string      `json:"tags,omitempty"`
+
+	// ETag identifies the version of the config returned by ReadMTEConfig. It is not part of the payload.
+	ETag string `json:"-"`
 }
 
 type BasicAuthDto struct {
@@ -12,6 +18,19 @@ type BasicAuthDto struct {
 	Password string `json:"password"`
 }
 
+type AccessControlDto struct {
+	IpAllowlist      []string        `json:"ipAllowlist,omitempty"`
+	IpDenylist       []string        `json:"ipDenylist,omitempty"`
+	CountryAllowlist []string        `json:"countryAllowlist,omitempty"`
+	CountryDenylist  []string        `json:"countryDenylist,omitempty"`
+	BypassToken      *BypassTokenDto `json:"bypassToken,omitempty"`
+}
+
+type BypassTokenDto struct {
+	Header string   `json:"header"`
+	Tokens []string `json:"tokens"`
+}
+
 type ConditionalHeaderDto struct {
 	MatchingHeader string `json:"matchingHeader"`
 	Pattern        string `json:"pattern"`
@@ -20,19 +39,85 @@ type ConditionalHeaderDto struct {
 	NoMatchValue   string `json:"noMatchValue"`
 }
 
+type HeaderRuleDto struct {
+	Route     string            `json:"route,omitempty"`
+	PathRules *MatcherDto       `json:"pathRules,omitempty"`
+	Request   *HeaderActionsDto `json:"request,omitempty"`
+	Response  *HeaderActionsDto `json:"response,omitempty"`
+}
+
+type HeaderActionsDto struct {
+	Set    map[string]string `json:"set,omitempty"`
+	Add    map[string]string `json:"add,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
+}
+
 type RouteDto struct {
-	Host               string         `json:"host"`
-	Path               string         `json:"path"`
-	EnableSsl          bool           `json:"enableSsl"`
-	PreservePathPrefix bool           `json:"preservePathPrefix"`
-	AppendPathPrefix   string         `json:"appendPathPrefix,omitempty"`
-	ShieldLocation     ShieldLocation `json:"shieldLocation,omitempty"`
+	Host                  string              `json:"host,omitempty"`
+	Path                  string              `json:"path"`
+	EnableSsl             bool                `json:"enableSsl"`
+	PreservePathPrefix    bool                `json:"preservePathPrefix"`
+	AppendPathPrefix      string              `json:"appendPathPrefix,omitempty"`
+	ShieldLocation        ShieldLocation      `json:"shieldLocation,omitempty"`
+	AccessControl         *AccessControlDto   `json:"accessControl,omitempty"`
+	ConnectTimeoutMs      *int64              `json:"connectTimeoutMs,omitempty"`
+	FirstByteTimeoutMs    *int64              `json:"firstByteTimeoutMs,omitempty"`
+	BetweenBytesTimeoutMs *int64              `json:"betweenBytesTimeoutMs,omitempty"`
+	RetryAttempts         *int64              `json:"retryAttempts,omitempty"`
+	HealthCheck           *HealthCheckDto     `json:"healthCheck,omitempty"`
+	Origins               []WeightedOriginDto `json:"origins,omitempty"`
+	StickyCookie          string              `json:"stickyCookie,omitempty"`
+	OriginOverrides       []OriginOverrideDto `json:"originOverrides,omitempty"`
+	OverrideHost          string              `json:"overrideHost,omitempty"`
+	SniHostname           string              `json:"sniHostname,omitempty"`
+	Port                  *int64              `json:"port,omitempty"`
+	VerifyCertificate     *bool               `json:"verifyCertificate,omitempty"`
+	Match                 *RouteMatchDto      `json:"match,omitempty"`
+}
+
+type RouteMatchDto struct {
+	Methods         []string            `json:"methods,omitempty"`
+	Headers         []MatchPredicateDto `json:"headers,omitempty"`
+	Cookies         []MatchPredicateDto `json:"cookies,omitempty"`
+	QueryParameters []MatchPredicateDto `json:"queryParameters,omitempty"`
+}
+
+type MatchPredicateDto struct {
+	Name    string `json:"name"`
+	Pattern string `json:"pattern"`
+}
+
+type WeightedOriginDto struct {
+	Host   string `json:"host"`
+	Weight int64  `json:"weight"`
+}
+
+type OriginOverrideDto struct {
+	Header string `json:"header"`
+	Value  string `json:"value"`
+	Host   string `json:"host"`
+}
+
+type HealthCheckDto struct {
+	Path               string   `json:"path"`
+	IntervalSeconds    *int64   `json:"intervalSeconds,omitempty"`
+	TimeoutMs          *int64   `json:"timeoutMs,omitempty"`
+	ExpectedStatus     *int64   `json:"expectedStatus,omitempty"`
+	HealthyThreshold   *int64   `json:"healthyThreshold,omitempty"`
+	UnhealthyThreshold *int64   `json:"unhealthyThreshold,omitempty"`
+	FallbackHosts      []string `json:"fallbackHosts,omitempty"`
 }
 
 type CacheDto struct {
-	Keys       *CacheKeyDto `json:"keys,omitempty"`
-	TtlSeconds *int64       `json:"ttlSeconds,omitempty"`
-	PathRules  *MatcherDto  `json:"pathRules,omitempty"`
+	Keys                        *CacheKeyDto        `json:"keys,omitempty"`
+	TtlSeconds                  *int64              `json:"ttlSeconds,omitempty"`
+	StatusTtlSeconds            map[string]int64    `json:"statusTtlSeconds,omitempty"`
+	StaleWhileRevalidateSeconds *int64              `json:"staleWhileRevalidateSeconds,omitempty"`
+	StaleIfErrorSeconds         *int64              `json:"staleIfErrorSeconds,omitempty"`
+	RespectOriginHeaders        *bool               `json:"respectOriginHeaders,omitempty"`
+	PathRules                   *MatcherDto         `json:"pathRules,omitempty"`
+	QueryParameters             *QueryParametersDto `json:"queryParameters,omitempty"`
+	Bypass                      *CacheBypassDto     `json:"bypass,omitempty"`
 }
 
 type MatcherDto struct {
@@ -45,21 +130,13 @@ type CacheKeyDto struct {
 	Cookie []string `json:"cookie"`
 }
 
-type ShieldLocation string
-
-const (
-	London        ShieldLocation = "London"
-	Manchester    ShieldLocation = "Manchester"
-	Frankfurt                    = "Frankfurt"
-	Madrid                       = "Madrid"
-	New_York_City                = "New York City"
-	Los_Angeles                  = "Los Angeles"
-	Toronto                      = "Toronto"
-	Johannesburg                 = "Johannesburg"
-	Seoul                        = "Seoul"
-	Sydney                       = "Sydney"
-	Tokyo                        = "Tokyo"
-	Hong_Kong                    = "Hong Kong"
-	Mumbai                       = "Mumbai"
-	Singapore                    = "Singapore"
-)
+type QueryParametersDto struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+	Sort    bool     `json:"sort"`
+}
+
+type CacheBypassDto struct {
+	Headers []string `json:"headers,omitempty"`
+	Cookies []string `json:"cookies,omitempty"`
+}
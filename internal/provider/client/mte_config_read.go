@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,9 +13,11 @@ type ReadMTEConfigInput struct {
 }
 
 func (c *Client) ReadMTEConfig(
+	ctx context.Context,
 	input ReadMTEConfigInput,
 ) (*MTEConfigDto, error) {
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("/v2/environment/%s/mte/altitude-config", input.EnvironmentId),
 		nil)
@@ -26,9 +29,11 @@ func (c *Client) ReadMTEConfig(
 		}
 	}
 	if httpRes.StatusCode == 404 {
-		return nil, &AltitudeClientError{
-			shortMessage: "Environment ID not found",
-			detail:       fmt.Sprintf("The Environment %s does not have associated config.", input.EnvironmentId),
+		return nil, &NotFoundError{
+			AltitudeClientError{
+				shortMessage: "Environment ID not found",
+				detail:       fmt.Sprintf("The Environment %s does not have associated config.", input.EnvironmentId),
+			},
 		}
 	}
 
@@ -60,5 +65,6 @@ func (c *Client) ReadMTEConfig(
 		}
 	}
 
+	dto.ETag = httpRes.Header.Get("ETag")
 	return &dto, nil
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,38 +12,52 @@ import (
 type UpdateMTEConfigInput struct {
 	Config        MTEConfigDto
 	EnvironmentId string
+	// ETag, when set, is sent as If-Match so the update fails if the config has changed since it was read.
+	ETag string
 }
 
 func (c *Client) UpdateMTEConfig(
+	ctx context.Context,
 	input UpdateMTEConfigInput,
-) error {
+) (string, error) {
 	jsonBody, err := json.Marshal(input.Config)
 	if err != nil {
-		return &AltitudeClientError{
+		return "", &AltitudeClientError{
 			"Input Error.",
 			"Input unable to be JSON encoded.",
 		}
 	}
 
-	httpRes, err := c.initiateRequest(
+	httpRes, err := c.initiateConditionalRequest(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf("/v2/environment/%s/mte/altitude-config", input.EnvironmentId),
-		bytes.NewBuffer(jsonBody))
+		bytes.NewBuffer(jsonBody),
+		input.ETag)
 
 	if err != nil {
-		return &AltitudeClientError{
+		return "", &AltitudeClientError{
 			shortMessage: "HTTP Error",
 			detail:       fmt.Sprintf("There has been an error with the http request, received error: %s", err),
 		}
 	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode == 412 {
+		return "", &PreconditionFailedError{
+			AltitudeClientError{
+				shortMessage: "MTE Config Modified",
+				detail:       fmt.Sprintf("The config of environment %s has been changed since it was last read.", input.EnvironmentId),
+			},
+		}
+	}
 
 	if httpRes.StatusCode != 201 {
-		defer httpRes.Body.Close()
 		body, _ := io.ReadAll(httpRes.Body)
-		return &AltitudeClientError{
+		return "", &AltitudeClientError{
 			shortMessage: "Unexpected API Response",
 			detail:       fmt.Sprintf("The Altitude API Request returned a non-200 response of %s with body %s.", httpRes.Status, body),
 		}
 	}
-	return nil
+	return httpRes.Header.Get("ETag"), nil
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ type CreateMteDomainMappingInput struct {
 }
 
 func (c *Client) CreateMteDomainMapping(
+	ctx context.Context,
 	input CreateMteDomainMappingInput,
 ) (string, error) {
 	jsonBody, err := json.Marshal(input.Config)
@@ -24,6 +26,7 @@ func (c *Client) CreateMteDomainMapping(
 	}
 
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodPost,
 		"/v1/mte/domain-mapping",
 		bytes.NewBuffer(jsonBody))
@@ -36,9 +39,11 @@ func (c *Client) CreateMteDomainMapping(
 	}
 
 	if httpRes.StatusCode == 409 {
-		return "", &AltitudeClientError{
-			shortMessage: "Domain Conflict",
-			detail:       "This domain already has an associated mapping.",
+		return "", &ConflictError{
+			AltitudeClientError{
+				shortMessage: "Domain Conflict",
+				detail:       "This domain already has an associated mapping.",
+			},
 		}
 	}
 
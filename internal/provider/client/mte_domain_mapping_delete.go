@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,9 +12,11 @@ type DeleteMteDomainMappingInput struct {
 }
 
 func (c *Client) DeleteMteDomainMapping(
+	ctx context.Context,
 	input DeleteMteDomainMappingInput,
 ) error {
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf("/v1/mte/domain-mapping?domain=%s", input.Domain),
 		nil,
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ type UpdateMteDomainMappingInput struct {
 }
 
 func (c *Client) UpdateMteDomainMapping(
+	ctx context.Context,
 	input UpdateMteDomainMappingInput,
 ) (string, error) {
 	jsonBody, err := json.Marshal(input.Config)
@@ -24,6 +26,7 @@ func (c *Client) UpdateMteDomainMapping(
 	}
 
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodPut,
 		"/v1/mte/domain-mapping",
 		bytes.NewBuffer(jsonBody))
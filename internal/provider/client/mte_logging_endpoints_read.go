@@ -1,17 +1,16 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 )
 
-func (c *Client) ReadMTELoggingEndpoints() (*MTELoggingEndpointsDto, error) {
-	httpRes, err := c.initiateRequest(
-		http.MethodGet,
-		"/v1/admin/logging",
-		nil)
+func (c *Client) ReadMTELoggingEndpoints(ctx context.Context) (*MTELoggingEndpointsDto, error) {
+	httpRes, err := c.initiateCachedRequest(
+		ctx,
+		"/v1/admin/logging")
 
 	if err != nil {
 		return nil, &AltitudeClientError{
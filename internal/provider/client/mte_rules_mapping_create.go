@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ type CreateMteRulesMappingInput struct {
 }
 
 func (c *Client) CreateMteRulesMapping(
+	ctx context.Context,
 	input CreateMteRulesMappingInput,
 ) error {
 	jsonBody, err := json.Marshal(input.Config)
@@ -24,6 +26,7 @@ func (c *Client) CreateMteRulesMapping(
 	}
 
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodPost,
 		"/v1/mte/rules-mapping",
 		bytes.NewBuffer(jsonBody))
@@ -36,9 +39,11 @@ func (c *Client) CreateMteRulesMapping(
 	}
 
 	if httpRes.StatusCode == 409 {
-		return &AltitudeClientError{
-			shortMessage: "Domain Conflict",
-			detail:       "This environment already has an associated config block.",
+		return &ConflictError{
+			AltitudeClientError{
+				shortMessage: "Domain Conflict",
+				detail:       "This environment already has an associated config block.",
+			},
 		}
 	}
 
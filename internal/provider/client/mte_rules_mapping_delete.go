@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,9 +12,11 @@ type DeleteMteRulesMappingInput struct {
 }
 
 func (c *Client) DeleteMteRulesMapping(
+	ctx context.Context,
 	input DeleteMteRulesMappingInput,
 ) error {
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf("/v1/mte/rules-mapping?domain=%s", input.Domain),
 		nil,
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,9 +12,11 @@ type ReadMteRulesMappingInput struct {
 }
 
 func (c *Client) ReadMteRulesMapping(
+	ctx context.Context,
 	input ReadMteRulesMappingInput,
 ) (string, error) {
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("/v1/mte/rules-mapping?domain=%s", input.Domain),
 		nil,
@@ -27,9 +30,11 @@ func (c *Client) ReadMteRulesMapping(
 	}
 
 	if httpRes.StatusCode == 404 {
-		return "", &AltitudeClientError{
-			shortMessage: "Domain not found",
-			detail:       fmt.Sprintf("The Domain %s does not have associated mapping.", input.Domain),
+		return "", &NotFoundError{
+			AltitudeClientError{
+				shortMessage: "Domain not found",
+				detail:       fmt.Sprintf("The Domain %s does not have associated mapping.", input.Domain),
+			},
 		}
 	}
 
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ type UpdateMteRulesMappingInput struct {
 }
 
 func (c *Client) UpdateMteRulesMapping(
+	ctx context.Context,
 	input UpdateMteRulesMappingInput,
 ) error {
 	jsonBody, err := json.Marshal(input.Config)
@@ -24,6 +26,7 @@ func (c *Client) UpdateMteRulesMapping(
 	}
 
 	httpRes, err := c.initiateRequest(
+		ctx,
 		http.MethodPut,
 		"/v1/mte/rules-mapping",
 		bytes.NewBuffer(jsonBody))
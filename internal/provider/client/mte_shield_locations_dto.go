@@ -0,0 +1,43 @@
+package client
+
+type ShieldLocation string
+
+const (
+	London        ShieldLocation = "London"
+	Manchester    ShieldLocation = "Manchester"
+	Frankfurt     ShieldLocation = "Frankfurt"
+	Madrid        ShieldLocation = "Madrid"
+	New_York_City ShieldLocation = "New York City"
+	Los_Angeles   ShieldLocation = "Los Angeles"
+	Toronto       ShieldLocation = "Toronto"
+	Johannesburg  ShieldLocation = "Johannesburg"
+	Seoul         ShieldLocation = "Seoul"
+	Sydney        ShieldLocation = "Sydney"
+	Tokyo         ShieldLocation = "Tokyo"
+	Hong_Kong     ShieldLocation = "Hong Kong"
+	Mumbai        ShieldLocation = "Mumbai"
+	Singapore     ShieldLocation = "Singapore"
+)
+
+// DefaultShieldLocations are the shield locations known when this version of the provider was
+// released. They are only used when the list cannot be read from the Altitude API.
+var DefaultShieldLocations = []ShieldLocation{
+	London,
+	Manchester,
+	Frankfurt,
+	Madrid,
+	New_York_City,
+	Los_Angeles,
+	Toronto,
+	Johannesburg,
+	Seoul,
+	Sydney,
+	Tokyo,
+	Hong_Kong,
+	Mumbai,
+	Singapore,
+}
+
+type MTEShieldLocationsDto struct {
+	Locations []ShieldLocation `json:"locations"`
+}
@@ -0,0 +1,44 @@
+package client
+
+import "golang.org/x/sync/semaphore"
+
+// Option configures optional behaviour of the Client.
+type Option func(*Client)
+
+// Endpoints overrides the URLs of the Altitude API and its token issuer which are otherwise chosen by the Mode.
+// Empty fields keep the URL of the Mode.
+type Endpoints struct {
+	BaseUrl  string
+	Audience string
+	Issuer   string
+}
+
+// WithEndpoints overrides the URLs used by the Client, such as to reach a private deployment.
+func WithEndpoints(endpoints Endpoints) Option {
+	return func(c *Client) {
+		if endpoints.BaseUrl != "" {
+			c.clientVariables.baseUrl = endpoints.BaseUrl
+		}
+		if endpoints.Audience != "" {
+			c.clientVariables.audience = endpoints.Audience
+		}
+		if endpoints.Issuer != "" {
+			c.clientVariables.issuer = endpoints.Issuer
+		}
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests to the Altitude API which may be in flight at once.
+func WithMaxConcurrentRequests(n int64) Option {
+	return func(c *Client) {
+		c.limiter.inFlight = semaphore.NewWeighted(n)
+	}
+}
+
+// WithRateLimit limits the rate of requests to the Altitude API, allowing bursts of up to
+// requestsPerSecond requests after a quiet period.
+func WithRateLimit(requestsPerSecond int64) Option {
+	return func(c *Client) {
+		c.limiter.bucket = newTokenBucket(float64(requestsPerSecond), float64(requestsPerSecond))
+	}
+}
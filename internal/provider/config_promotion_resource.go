@@ -0,0 +1,465 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"terraform-provider-altitude/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MTEConfigPromotionResource{}
+var _ resource.ResourceWithModifyPlan = &MTEConfigPromotionResource{}
+var _ resource.ResourceWithImportState = &MTEConfigPromotionResource{}
+
+func NewMTEConfigPromotionResource() resource.Resource {
+	return &MTEConfigPromotionResource{}
+}
+
+type MTEConfigPromotionResource struct {
+	client *client.Client
+}
+
+type MTEConfigPromotionResourceModel struct {
+	SourceEnvironmentId types.String            `tfsdk:"source_environment_id"`
+	TargetEnvironmentId types.String            `tfsdk:"target_environment_id"`
+	HostSubstitutions   map[string]types.String `tfsdk:"host_substitutions"`
+	ShieldLocation      types.String            `tfsdk:"shield_location"`
+	RemoveBasicAuth     types.Bool              `tfsdk:"remove_basic_auth"`
+	PromotedConfig      types.String            `tfsdk:"promoted_config"`
+	Timeouts            timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// Metadata implements resource.Resource.
+func (m *MTEConfigPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mte_config_promotion"
+}
+
+func (m *MTEConfigPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	resourceData, ok := req.ProviderData.(*ConfiguredData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ConfiguredData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	m.client = resourceData.client
+}
+
+// Schema implements resource.Resource.
+func (m *MTEConfigPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A resource which copies the config of one environment to another, such as from UAT to Production, applying " +
+			"substitutions on the way. The source config is read when planning, so the plan shows exactly what will be written to the target. " +
+			"Destroying this resource leaves the target config in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"source_environment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The environment ID whose config is copied.",
+			},
+			"target_environment_id": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The environment ID whose config is replaced. This must not also be managed by an `altitude_mte_config` resource, " +
+					"as the two would overwrite each other.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host_substitutions": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "A map of hosts in the source config to the hosts which replace them in the target, such as " +
+					"`{ \"uat.example.com\" = \"www.example.com\" }`. This applies to every host in a route, including `origins`, " +
+					"`override_host`, `sni_hostname` and `health_check.fallback_hosts`.",
+			},
+			"shield_location": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A shield location which replaces the `shield_location` of every route which has one.",
+			},
+			"remove_basic_auth": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "A boolean specifying whether `basic_auth` is removed from the promoted config. Defaults to `false`.",
+			},
+			"promoted_config": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The JSON encoded config written to the target environment. Basic auth passwords and bypass tokens " +
+					"are replaced with `" + redactedValue + "`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (m *MTEConfigPromotionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || m.client == nil {
+		return
+	}
+
+	var data MTEConfigPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m.validateShieldLocation(ctx, &data, resp)
+
+	if resp.Diagnostics.HasError() || !data.isKnown() {
+		return
+	}
+
+	config, err := m.promote(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_environment_id"),
+			"Failed to read source MTE config",
+			"An error occurred while reading the config to promote.\n\n"+
+				"JSON Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("promoted_config"), redactConfig(config))...)
+}
+
+// validateShieldLocation checks the overriding shield location against those supported by the Altitude API,
+// in the same way as the shield locations of an `altitude_mte_config`.
+func (m *MTEConfigPromotionResource) validateShieldLocation(ctx context.Context, data *MTEConfigPromotionResourceModel, resp *resource.ModifyPlanResponse) {
+	if data.ShieldLocation.IsNull() || data.ShieldLocation.IsUnknown() {
+		return
+	}
+
+	shieldLocations, err := readShieldLocations(ctx, m.client)
+	if slices.Contains(shieldLocations, client.ShieldLocation(data.ShieldLocation.ValueString())) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("shield_location"),
+			"Unrecognised Shield Location",
+			fmt.Sprintf("The shield location %q is not in the list embedded in the provider and the Altitude API could not be reached to check it. "+
+				"It will be rejected when applied if it does not exist.\n\n%s", data.ShieldLocation.ValueString(), err.Error()),
+		)
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("shield_location"),
+		"Invalid Shield Location",
+		fmt.Sprintf("The shield location %q does not exist. Use the `altitude_mte_shield_locations` data source to list the available locations.", data.ShieldLocation.ValueString()),
+	)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (m *MTEConfigPromotionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := splitImportId(req.ID, 2, "<source_environment_id>/<target_environment_id>", &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, environmentId := range parts {
+		_, err := m.client.ReadMTEConfig(ctx, client.ReadMTEConfigInput{EnvironmentId: environmentId})
+
+		var notFoundErr *client.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.Diagnostics.AddError(
+				"Cannot Import Non-Existent MTE Config",
+				fmt.Sprintf("The %s environment %q has no config. Check the environment IDs are correct and in the order %q.",
+					[]string{"source", "target"}[i], environmentId, "<source_environment_id>/<target_environment_id>"),
+			)
+			return
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Import Resource",
+				"An unexpected error occurred while checking the config exists:\n\n"+
+					err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_environment_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remove_basic_auth"), false)...)
+}
+
+// Create implements resource.Resource.
+func (m *MTEConfigPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MTEConfigPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	m.write(ctx, &data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource.Resource.
+func (m *MTEConfigPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MTEConfigPromotionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	apiDto, err := m.client.ReadMTEConfig(
+		ctx,
+		client.ReadMTEConfigInput{
+			EnvironmentId: data.TargetEnvironmentId.ValueString(),
+		},
+	)
+
+	var notFoundErr *client.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Get Resource",
+			"An unexpected error occurred while executing the request. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"JSON Error: "+err.Error(),
+		)
+		return
+	}
+
+	// The target is stored as the API returns it, so changes made outside of Terraform are promoted over.
+	data.PromotedConfig = redactConfig(apiDto)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (m *MTEConfigPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MTEConfigPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	m.write(ctx, &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource. The target config is left in place.
+func (m *MTEConfigPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// isKnown reports whether every value needed to promote the config is known.
+func (d *MTEConfigPromotionResourceModel) isKnown() bool {
+	if d.SourceEnvironmentId.IsUnknown() || d.ShieldLocation.IsUnknown() || d.RemoveBasicAuth.IsUnknown() {
+		return false
+	}
+	for _, v := range d.HostSubstitutions {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// promote reads the source config and returns it with the substitutions applied.
+func (m *MTEConfigPromotionResource) promote(ctx context.Context, data *MTEConfigPromotionResourceModel) (*client.MTEConfigDto, error) {
+	apiDto, err := m.client.ReadMTEConfig(
+		ctx,
+		client.ReadMTEConfigInput{
+			EnvironmentId: data.SourceEnvironmentId.ValueString(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := make(map[string]string, len(data.HostSubstitutions))
+	for k, v := range data.HostSubstitutions {
+		hosts[k] = v.ValueString()
+	}
+	promoteConfig(apiDto, hosts, client.ShieldLocation(data.ShieldLocation.ValueString()), data.RemoveBasicAuth.ValueBool())
+
+	return apiDto, nil
+}
+
+// write promotes the source config to the target environment, creating its config if it has none. The
+// source is read again as secrets are not kept in the plan, so the apply fails if it changed since planning.
+func (m *MTEConfigPromotionResource) write(ctx context.Context, data *MTEConfigPromotionResourceModel, diags *diag.Diagnostics) {
+	config, err := m.promote(ctx, data)
+	if err != nil {
+		diags.AddError(
+			"Failed to read source MTE config",
+			"An error occurred while reading the config to promote.\n\n"+
+				"JSON Error: "+err.Error())
+		return
+	}
+
+	promotedConfig := redactConfig(config)
+	if !data.PromotedConfig.IsUnknown() && !data.PromotedConfig.Equal(promotedConfig) {
+		diags.AddError(
+			"Source MTE config changed",
+			fmt.Sprintf("The config of environment %s changed after the plan was made. Plan again to review the new changes before applying.",
+				data.SourceEnvironmentId.ValueString()))
+		return
+	}
+	data.PromotedConfig = promotedConfig
+
+	existing, err := m.client.ReadMTEConfig(ctx, client.ReadMTEConfigInput{EnvironmentId: data.TargetEnvironmentId.ValueString()})
+	var notFoundErr *client.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		_, err = m.client.CreateMTEConfig(ctx, client.CreateMTEConfigInput{
+			Config:        *config,
+			EnvironmentId: data.TargetEnvironmentId.ValueString(),
+		})
+	} else if err == nil {
+		_, err = m.client.UpdateMTEConfig(ctx, client.UpdateMTEConfigInput{
+			Config:        *config,
+			EnvironmentId: data.TargetEnvironmentId.ValueString(),
+			ETag:          existing.ETag,
+		})
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Failed to promote MTE config",
+			"An error occurred while writing the config to the target environment. "+
+				"If unexpected, please report this issue to the provider developers.\n\n"+
+				"JSON Error: "+err.Error())
+	}
+}
+
+// redactedValue replaces secrets in configs shown in the plan.
+const redactedValue = "(sensitive)"
+
+// redactConfig returns config encoded as JSON with its basic auth password and bypass tokens redacted.
+func redactConfig(config *client.MTEConfigDto) types.String {
+	var redacted client.MTEConfigDto
+	body, _ := json.Marshal(config)
+	_ = json.Unmarshal(body, &redacted)
+
+	redactAccessControl := func(a *client.AccessControlDto) {
+		if a != nil && a.BypassToken != nil {
+			for i := range a.BypassToken.Tokens {
+				a.BypassToken.Tokens[i] = redactedValue
+			}
+		}
+	}
+	if redacted.BasicAuth != nil {
+		redacted.BasicAuth.Password = redactedValue
+	}
+	redactAccessControl(redacted.AccessControl)
+	for _, r := range redacted.Routes {
+		redactAccessControl(r.AccessControl)
+	}
+
+	body, _ = json.Marshal(redacted)
+	return types.StringValue(string(body))
+}
+
+// promoteConfig applies the host substitutions, shield location and basic auth removal of a promotion to config.
+func promoteConfig(config *client.MTEConfigDto, hosts map[string]string, shieldLocation client.ShieldLocation, removeBasicAuth bool) {
+	substitute := func(host string) string {
+		if h, ok := hosts[host]; ok {
+			return h
+		}
+		return host
+	}
+
+	for i := range config.Routes {
+		r := &config.Routes[i]
+		r.Host = substitute(r.Host)
+		r.OverrideHost = substitute(r.OverrideHost)
+		r.SniHostname = substitute(r.SniHostname)
+		for j := range r.Origins {
+			r.Origins[j].Host = substitute(r.Origins[j].Host)
+		}
+		for j := range r.OriginOverrides {
+			r.OriginOverrides[j].Host = substitute(r.OriginOverrides[j].Host)
+		}
+		if r.HealthCheck != nil {
+			for j, h := range r.HealthCheck.FallbackHosts {
+				r.HealthCheck.FallbackHosts[j] = substitute(h)
+			}
+		}
+		if shieldLocation != "" && r.ShieldLocation != "" {
+			r.ShieldLocation = shieldLocation
+		}
+	}
+
+	if removeBasicAuth {
+		config.BasicAuth = nil
+	}
+}
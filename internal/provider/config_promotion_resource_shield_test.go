@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-altitude/internal/provider/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMTEConfigPromotionResourceValidateShieldLocation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"locations":["London","Frankfurt"]}`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewWithAuthenticator(client.AccessToken{Token: "test"}, client.Local, client.WithEndpoints(client.Endpoints{BaseUrl: server.URL}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &MTEConfigPromotionResource{client: c}
+
+	testCases := map[string]struct {
+		shieldLocation types.String
+		isError        bool
+	}{
+		"unset":   {shieldLocation: types.StringNull()},
+		"unknown": {shieldLocation: types.StringUnknown()},
+		"valid":   {shieldLocation: types.StringValue("Frankfurt")},
+		"invalid": {shieldLocation: types.StringValue("Frankfort"), isError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var resp resource.ModifyPlanResponse
+			m.validateShieldLocation(context.Background(), &MTEConfigPromotionResourceModel{ShieldLocation: tc.shieldLocation}, &resp)
+
+			if resp.Diagnostics.HasError() != tc.isError {
+				t.Errorf("expected error=%v, got diagnostics %v", tc.isError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-altitude/internal/provider/client"
+)
+
+func TestAccConfigPromotionResource(t *testing.T) {
+	var source_env_id = randomString(10)
+	var target_env_id = randomString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigPromotionResource(source_env_id, target_env_id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config_promotion.test", "target_environment_id", target_env_id),
+					resource.TestCheckResourceAttrWith("altitude_mte_config_promotion.test", "promoted_config", func(value string) error {
+						if !strings.Contains(value, `"host":"www.thgaltitude.com"`) {
+							return fmt.Errorf("expected the promoted host to be substituted, got %s", value)
+						}
+						if strings.Contains(value, "basicAuth") {
+							return fmt.Errorf("expected basic auth to be removed, got %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigPromotionResource(sourceEnvironmentId string, targetEnvironmentId string) string {
+	return fmt.Sprintf(`
+resource "altitude_mte_config" "source" {
+  environment_id = %[1]q
+  config = {
+    routes = [
+      {
+        host                 = "uat.thgaltitude.com"
+        path                 = "/"
+        enable_ssl           = true
+        preserve_path_prefix = true
+        shield_location      = "London"
+      }
+    ]
+    basic_auth = {
+      username = "uat"
+      password = "password"
+    }
+  }
+}
+
+resource "altitude_mte_config_promotion" "test" {
+  source_environment_id = altitude_mte_config.source.environment_id
+  target_environment_id = %[2]q
+  host_substitutions = {
+    "uat.thgaltitude.com" = "www.thgaltitude.com"
+  }
+  shield_location   = "Frankfurt"
+  remove_basic_auth = true
+}
+`, sourceEnvironmentId, targetEnvironmentId)
+}
+
+func TestPromoteConfig(t *testing.T) {
+	config := &client.MTEConfigDto{
+		Routes: []client.RouteDto{
+			{
+				Host:           "uat.thgaltitude.com",
+				OverrideHost:   "uat-origin.thgaltitude.com",
+				ShieldLocation: client.London,
+				HealthCheck:    &client.HealthCheckDto{FallbackHosts: []string{"uat-backup.thgaltitude.com", "static.thgaltitude.com"}},
+			},
+			{
+				Origins: []client.WeightedOriginDto{{Host: "uat.thgaltitude.com", Weight: 100}},
+			},
+		},
+		BasicAuth: &client.BasicAuthDto{Username: "uat", Password: "password"},
+	}
+	hosts := map[string]string{
+		"uat.thgaltitude.com":        "www.thgaltitude.com",
+		"uat-origin.thgaltitude.com": "origin.thgaltitude.com",
+		"uat-backup.thgaltitude.com": "backup.thgaltitude.com",
+	}
+
+	promoteConfig(config, hosts, client.Frankfurt, true)
+
+	if config.Routes[0].Host != "www.thgaltitude.com" || config.Routes[0].OverrideHost != "origin.thgaltitude.com" {
+		t.Errorf("expected route hosts to be substituted, got %+v", config.Routes[0])
+	}
+	if fallbackHosts := config.Routes[0].HealthCheck.FallbackHosts; fallbackHosts[0] != "backup.thgaltitude.com" || fallbackHosts[1] != "static.thgaltitude.com" {
+		t.Errorf("expected only matching fallback hosts to be substituted, got %v", fallbackHosts)
+	}
+	if config.Routes[1].Origins[0].Host != "www.thgaltitude.com" {
+		t.Errorf("expected origin hosts to be substituted, got %+v", config.Routes[1].Origins)
+	}
+	if config.Routes[0].ShieldLocation != client.Frankfurt || config.Routes[1].ShieldLocation != "" {
+		t.Errorf("expected only set shield locations to be replaced, got %q and %q", config.Routes[0].ShieldLocation, config.Routes[1].ShieldLocation)
+	}
+	if config.BasicAuth != nil {
+		t.Errorf("expected basic auth to be removed, got %+v", config.BasicAuth)
+	}
+}
+
+func TestRedactConfig(t *testing.T) {
+	config := &client.MTEConfigDto{
+		BasicAuth:     &client.BasicAuthDto{Username: "uat", Password: "hunter2"},
+		AccessControl: &client.AccessControlDto{BypassToken: &client.BypassTokenDto{Header: "X-Bypass", Tokens: []string{"secret"}}},
+	}
+
+	redacted := redactConfig(config).ValueString()
+
+	if strings.Contains(redacted, "hunter2") || strings.Contains(redacted, "secret") {
+		t.Errorf("expected secrets to be redacted, got %s", redacted)
+	}
+	if config.BasicAuth.Password != "hunter2" || config.AccessControl.BypassToken.Tokens[0] != "secret" {
+		t.Errorf("expected the original config to be unchanged, got %+v", config)
+	}
+}
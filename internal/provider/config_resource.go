@@ -2,13 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"terraform-provider-altitude/internal/provider/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,56 +28,130 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MTEConfigResource{}
 var _ resource.ResourceWithImportState = &MTEConfigResource{}
+var _ resource.ResourceWithUpgradeState = &MTEConfigResource{}
+var _ resource.ResourceWithModifyPlan = &MTEConfigResource{}
 
 func NewMTEConfigResource() resource.Resource {
 	return &MTEConfigResource{}
 }
 
 type MTEConfigResource struct {
-	client *client.Client
+	client   *client.Client
+	defaults ProviderDefaults
 }
 
 type MTEConfigResourceModel struct {
-	EnvironmentId types.String   `tfsdk:"environment_id"`
-	Config        MTEConfigModel `tfsdk:"config"`
+	EnvironmentId   types.String   `tfsdk:"environment_id"`
+	Config          MTEConfigModel `tfsdk:"config"`
+	Tags            types.Map      `tfsdk:"tags"`
+	TagsAll         types.Map      `tfsdk:"tags_all"`
+	RenderedPayload types.String   `tfsdk:"rendered_payload"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type MTEConfigModel struct {
 	Routes             []RouteModel             `tfsdk:"routes"`
 	BasicAuth          *BasicAuthModel          `tfsdk:"basic_auth"`
+	AccessControl      *AccessControlModel      `tfsdk:"access_control"`
 	ConditionalHeaders []ConditionalHeaderModel `tfsdk:"conditional_headers"`
 	Cache              []CacheModel             `tfsdk:"cache"`
+	Headers            []HeaderRuleModel        `tfsdk:"headers"`
+}
+
+func (c *MTEConfigModel) hasRoute(routePath string) bool {
+	for _, r := range c.Routes {
+		if r.Path.IsUnknown() || r.Path.ValueString() == routePath {
+			return true
+		}
+	}
+	return false
 }
 
 type RouteModel struct {
-	Host               types.String `tfsdk:"host"`
-	Path               types.String `tfsdk:"path"`
-	EnableSsl          types.Bool   `tfsdk:"enable_ssl"`
-	PreservePathPrefix types.Bool   `tfsdk:"preserve_path_prefix"`
-	AppendPathPrefix   types.String `tfsdk:"append_path_prefix"`
-	ShieldLocation     types.String `tfsdk:"shield_location"`
+	Host                  types.String          `tfsdk:"host"`
+	Path                  types.String          `tfsdk:"path"`
+	EnableSsl             types.Bool            `tfsdk:"enable_ssl"`
+	PreservePathPrefix    types.Bool            `tfsdk:"preserve_path_prefix"`
+	AppendPathPrefix      types.String          `tfsdk:"append_path_prefix"`
+	ShieldLocation        types.String          `tfsdk:"shield_location"`
+	AccessControl         *AccessControlModel   `tfsdk:"access_control"`
+	ConnectTimeoutMs      types.Int64           `tfsdk:"connect_timeout_ms"`
+	FirstByteTimeoutMs    types.Int64           `tfsdk:"first_byte_timeout_ms"`
+	BetweenBytesTimeoutMs types.Int64           `tfsdk:"between_bytes_timeout_ms"`
+	RetryAttempts         types.Int64           `tfsdk:"retry_attempts"`
+	HealthCheck           *HealthCheckModel     `tfsdk:"health_check"`
+	Origins               []WeightedOriginModel `tfsdk:"origins"`
+	StickyCookie          types.String          `tfsdk:"sticky_cookie"`
+	OriginOverrides       []OriginOverrideModel `tfsdk:"origin_overrides"`
+	OverrideHost          types.String          `tfsdk:"override_host"`
+	SniHostname           types.String          `tfsdk:"sni_hostname"`
+	Port                  types.Int64           `tfsdk:"port"`
+	VerifyCertificate     types.Bool            `tfsdk:"verify_certificate"`
+	Match                 *RouteMatchModel      `tfsdk:"match"`
 }
 
 func (r *RouteModel) transformToDto() client.RouteDto {
 	var routesPostBody = client.RouteDto{
-		Host:               r.Host.ValueString(),
-		Path:               r.Path.ValueString(),
-		EnableSsl:          r.EnableSsl.ValueBool(),
-		PreservePathPrefix: r.PreservePathPrefix.ValueBool(),
-		ShieldLocation:     client.ShieldLocation(r.ShieldLocation.ValueString()),
+		Host:                  r.Host.ValueString(),
+		Path:                  r.Path.ValueString(),
+		EnableSsl:             r.EnableSsl.ValueBool(),
+		PreservePathPrefix:    r.PreservePathPrefix.ValueBool(),
+		ShieldLocation:        client.ShieldLocation(r.ShieldLocation.ValueString()),
+		ConnectTimeoutMs:      r.ConnectTimeoutMs.ValueInt64Pointer(),
+		FirstByteTimeoutMs:    r.FirstByteTimeoutMs.ValueInt64Pointer(),
+		BetweenBytesTimeoutMs: r.BetweenBytesTimeoutMs.ValueInt64Pointer(),
+		RetryAttempts:         r.RetryAttempts.ValueInt64Pointer(),
+		OverrideHost:          r.OverrideHost.ValueString(),
+		SniHostname:           r.SniHostname.ValueString(),
+		Port:                  r.Port.ValueInt64Pointer(),
+		VerifyCertificate:     r.VerifyCertificate.ValueBoolPointer(),
 	}
 	if r.AppendPathPrefix.ValueString() != "" {
 		routesPostBody.AppendPathPrefix = r.AppendPathPrefix.ValueString()
 	}
+	if r.AccessControl != nil {
+		routesPostBody.AccessControl = r.AccessControl.transformToDto()
+	}
+	if r.HealthCheck != nil {
+		routesPostBody.HealthCheck = r.HealthCheck.transformToDto()
+	}
+	if r.Origins != nil {
+		routesPostBody.Origins = make([]client.WeightedOriginDto, len(r.Origins))
+		for i, o := range r.Origins {
+			routesPostBody.Origins[i] = client.WeightedOriginDto{
+				Host:   o.Host.ValueString(),
+				Weight: o.Weight.ValueInt64(),
+			}
+		}
+		routesPostBody.StickyCookie = r.StickyCookie.ValueString()
+	}
+	if r.OriginOverrides != nil {
+		routesPostBody.OriginOverrides = make([]client.OriginOverrideDto, len(r.OriginOverrides))
+		for i, o := range r.OriginOverrides {
+			routesPostBody.OriginOverrides[i] = client.OriginOverrideDto{
+				Header: o.Header.ValueString(),
+				Value:  o.Value.ValueString(),
+				Host:   o.Host.ValueString(),
+			}
+		}
+	}
+	if r.Match != nil {
+		routesPostBody.Match = r.Match.transformToDto()
+	}
 	return routesPostBody
 }
 
 func transformRouteToResourceModel(r *client.RouteDto) RouteModel {
 	var routesPostBody = RouteModel{
-		Host:               types.StringValue(r.Host),
-		Path:               types.StringValue(r.Path),
-		EnableSsl:          types.BoolValue(r.EnableSsl),
-		PreservePathPrefix: types.BoolValue(r.PreservePathPrefix),
+		Path:                  types.StringValue(r.Path),
+		EnableSsl:             types.BoolValue(r.EnableSsl),
+		PreservePathPrefix:    types.BoolValue(r.PreservePathPrefix),
+		ConnectTimeoutMs:      types.Int64PointerValue(r.ConnectTimeoutMs),
+		FirstByteTimeoutMs:    types.Int64PointerValue(r.FirstByteTimeoutMs),
+		BetweenBytesTimeoutMs: types.Int64PointerValue(r.BetweenBytesTimeoutMs),
+		RetryAttempts:         types.Int64PointerValue(r.RetryAttempts),
+		Port:                  types.Int64PointerValue(r.Port),
+		VerifyCertificate:     types.BoolPointerValue(r.VerifyCertificate),
 	}
 	if r.ShieldLocation != "" {
 		routesPostBody.ShieldLocation = types.StringValue(string(r.ShieldLocation))
@@ -76,18 +159,291 @@ func transformRouteToResourceModel(r *client.RouteDto) RouteModel {
 	if r.AppendPathPrefix != "" {
 		routesPostBody.AppendPathPrefix = types.StringValue(r.AppendPathPrefix)
 	}
+	if r.AccessControl != nil {
+		routesPostBody.AccessControl = transformAccessControlToResourceModel(r.AccessControl)
+	}
+	if r.HealthCheck != nil {
+		routesPostBody.HealthCheck = transformHealthCheckToResourceModel(r.HealthCheck)
+	}
+	if r.Host != "" {
+		routesPostBody.Host = types.StringValue(r.Host)
+	}
+	if r.Origins != nil {
+		routesPostBody.Origins = make([]WeightedOriginModel, len(r.Origins))
+		for i, o := range r.Origins {
+			routesPostBody.Origins[i] = WeightedOriginModel{
+				Host:   types.StringValue(o.Host),
+				Weight: types.Int64Value(o.Weight),
+			}
+		}
+	}
+	if r.StickyCookie != "" {
+		routesPostBody.StickyCookie = types.StringValue(r.StickyCookie)
+	}
+	if r.OverrideHost != "" {
+		routesPostBody.OverrideHost = types.StringValue(r.OverrideHost)
+	}
+	if r.Match != nil {
+		routesPostBody.Match = transformRouteMatchToResourceModel(r.Match)
+	}
+	if r.SniHostname != "" {
+		routesPostBody.SniHostname = types.StringValue(r.SniHostname)
+	}
+	if r.OriginOverrides != nil {
+		routesPostBody.OriginOverrides = make([]OriginOverrideModel, len(r.OriginOverrides))
+		for i, o := range r.OriginOverrides {
+			routesPostBody.OriginOverrides[i] = OriginOverrideModel{
+				Header: types.StringValue(o.Header),
+				Value:  types.StringValue(o.Value),
+				Host:   types.StringValue(o.Host),
+			}
+		}
+	}
 	return routesPostBody
 }
 
+type RouteMatchModel struct {
+	Methods         UnorderedListValue    `tfsdk:"methods"`
+	Headers         []MatchPredicateModel `tfsdk:"headers"`
+	Cookies         []MatchPredicateModel `tfsdk:"cookies"`
+	QueryParameters []MatchPredicateModel `tfsdk:"query_parameters"`
+}
+
+type MatchPredicateModel struct {
+	Name    types.String `tfsdk:"name"`
+	Pattern types.String `tfsdk:"pattern"`
+}
+
+func (m *RouteMatchModel) isEmpty() bool {
+	return len(m.Methods.ValueStrings()) == 0 && len(m.Headers) == 0 && len(m.Cookies) == 0 && len(m.QueryParameters) == 0
+}
+
+func (m *RouteMatchModel) transformToDto() *client.RouteMatchDto {
+	var matchBody = client.RouteMatchDto{
+		Headers:         transformMatchPredicatesToDto(m.Headers),
+		Cookies:         transformMatchPredicatesToDto(m.Cookies),
+		QueryParameters: transformMatchPredicatesToDto(m.QueryParameters),
+	}
+	if !m.Methods.IsNull() {
+		matchBody.Methods = m.Methods.ValueStrings()
+	}
+	return &matchBody
+}
+
+func transformRouteMatchToResourceModel(m *client.RouteMatchDto) *RouteMatchModel {
+	return &RouteMatchModel{
+		Methods:         NewUnorderedListValueOrNull(m.Methods, false),
+		Headers:         transformMatchPredicatesToResourceModel(m.Headers),
+		Cookies:         transformMatchPredicatesToResourceModel(m.Cookies),
+		QueryParameters: transformMatchPredicatesToResourceModel(m.QueryParameters),
+	}
+}
+
+func transformMatchPredicatesToDto(predicates []MatchPredicateModel) []client.MatchPredicateDto {
+	if predicates == nil {
+		return nil
+	}
+	var predicatesBody = make([]client.MatchPredicateDto, len(predicates))
+	for i, p := range predicates {
+		predicatesBody[i] = client.MatchPredicateDto{
+			Name:    p.Name.ValueString(),
+			Pattern: p.Pattern.ValueString(),
+		}
+	}
+	return predicatesBody
+}
+
+func transformMatchPredicatesToResourceModel(predicates []client.MatchPredicateDto) []MatchPredicateModel {
+	if predicates == nil {
+		return nil
+	}
+	var predicateModels = make([]MatchPredicateModel, len(predicates))
+	for i, p := range predicates {
+		predicateModels[i] = MatchPredicateModel{
+			Name:    types.StringValue(p.Name),
+			Pattern: types.StringValue(p.Pattern),
+		}
+	}
+	return predicateModels
+}
+
+// matchPredicatesAttribute returns the schema for a list of name and regex pairs which must all match.
+func matchPredicatesAttribute(desc string, nameValidators ...validator.String) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: desc,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The name to match.",
+					Validators:          nameValidators,
+				},
+				"pattern": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "A regex pattern which the value must match. The regex must cover the whole value.",
+					Validators: []validator.String{
+						regexValidator{},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WeightedOriginModel struct {
+	Host   types.String `tfsdk:"host"`
+	Weight types.Int64  `tfsdk:"weight"`
+}
+
+type OriginOverrideModel struct {
+	Header types.String `tfsdk:"header"`
+	Value  types.String `tfsdk:"value"`
+	Host   types.String `tfsdk:"host"`
+}
+
+// validateOrigins checks that a route directs to either a single host or a set of weighted
+// origins, and that the weights and overrides of those origins are consistent with one another.
+func (r *RouteModel) validateOrigins(p path.Path, diags *diag.Diagnostics) {
+	if r.Origins == nil {
+		if r.Host.IsNull() {
+			diags.AddAttributeError(
+				p,
+				"Missing Attribute Configuration",
+				"Expected either `host` or `origins` to be set inside the route object.",
+			)
+		}
+		if !r.StickyCookie.IsNull() || r.OriginOverrides != nil {
+			diags.AddAttributeError(
+				p,
+				"Invalid Attribute Configuration",
+				"`sticky_cookie` and `origin_overrides` can only be set on a route which defines `origins`.",
+			)
+		}
+		return
+	}
+	if !r.Host.IsNull() && !r.Host.IsUnknown() {
+		diags.AddAttributeError(
+			p.AtName("host"),
+			"Invalid Attribute Combination",
+			"`host` cannot be set alongside `origins`. Move the host into `origins` with the weight it should receive.",
+		)
+	}
+
+	var total int64
+	var weightsKnown, hostsKnown = true, true
+	var hosts = make(map[string]bool, len(r.Origins))
+	for i, o := range r.Origins {
+		if o.Weight.IsUnknown() {
+			weightsKnown = false
+		} else {
+			total += o.Weight.ValueInt64()
+		}
+		if o.Host.IsUnknown() {
+			hostsKnown = false
+			continue
+		}
+		if hosts[o.Host.ValueString()] {
+			diags.AddAttributeError(
+				p.AtName("origins").AtListIndex(i).AtName("host"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("The origin %q is defined more than once in this route.", o.Host.ValueString()),
+			)
+		}
+		hosts[o.Host.ValueString()] = true
+	}
+	if weightsKnown && total != 100 {
+		diags.AddAttributeError(
+			p.AtName("origins"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("Expected the weights of all origins in this route to sum to 100, got %d.", total),
+		)
+	}
+
+	for i, o := range r.OriginOverrides {
+		if hostsKnown && !o.Host.IsUnknown() && !hosts[o.Host.ValueString()] {
+			diags.AddAttributeError(
+				p.AtName("origin_overrides").AtListIndex(i).AtName("host"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("The origin %q is not one of the `origins` defined in this route.", o.Host.ValueString()),
+			)
+		}
+	}
+}
+
+type HealthCheckModel struct {
+	Path               types.String   `tfsdk:"path"`
+	IntervalSeconds    types.Int64    `tfsdk:"interval_seconds"`
+	TimeoutMs          types.Int64    `tfsdk:"timeout_ms"`
+	ExpectedStatus     types.Int64    `tfsdk:"expected_status"`
+	HealthyThreshold   types.Int64    `tfsdk:"healthy_threshold"`
+	UnhealthyThreshold types.Int64    `tfsdk:"unhealthy_threshold"`
+	FallbackHosts      []types.String `tfsdk:"fallback_hosts"`
+}
+
+func (h *HealthCheckModel) transformToDto() *client.HealthCheckDto {
+	var healthCheckBody = client.HealthCheckDto{
+		Path:               h.Path.ValueString(),
+		IntervalSeconds:    h.IntervalSeconds.ValueInt64Pointer(),
+		TimeoutMs:          h.TimeoutMs.ValueInt64Pointer(),
+		ExpectedStatus:     h.ExpectedStatus.ValueInt64Pointer(),
+		HealthyThreshold:   h.HealthyThreshold.ValueInt64Pointer(),
+		UnhealthyThreshold: h.UnhealthyThreshold.ValueInt64Pointer(),
+	}
+	if h.FallbackHosts != nil {
+		healthCheckBody.FallbackHosts = make([]string, len(h.FallbackHosts))
+		for i, f := range h.FallbackHosts {
+			healthCheckBody.FallbackHosts[i] = f.ValueString()
+		}
+	}
+	return &healthCheckBody
+}
+
+func transformHealthCheckToResourceModel(h *client.HealthCheckDto) *HealthCheckModel {
+	var healthCheckModel = HealthCheckModel{
+		Path:               types.StringValue(h.Path),
+		IntervalSeconds:    types.Int64PointerValue(h.IntervalSeconds),
+		TimeoutMs:          types.Int64PointerValue(h.TimeoutMs),
+		ExpectedStatus:     types.Int64PointerValue(h.ExpectedStatus),
+		HealthyThreshold:   types.Int64PointerValue(h.HealthyThreshold),
+		UnhealthyThreshold: types.Int64PointerValue(h.UnhealthyThreshold),
+	}
+	if h.FallbackHosts != nil {
+		healthCheckModel.FallbackHosts = make([]types.String, len(h.FallbackHosts))
+		for i, f := range h.FallbackHosts {
+			healthCheckModel.FallbackHosts[i] = types.StringValue(f)
+		}
+	}
+	return &healthCheckModel
+}
+
+// maxStaleWindowSeconds bounds how long stale content may be served, which is 7 days.
+const maxStaleWindowSeconds = 604800
+
 type CacheModel struct {
-	Keys       *CacheKeyModel `tfsdk:"keys"`
-	TtlSeconds types.Int64    `tfsdk:"ttl_seconds"`
-	PathRules  *GlobMatcher   `tfsdk:"path_rules"`
+	Keys                        *CacheKeyModel         `tfsdk:"keys"`
+	TtlSeconds                  types.Int64            `tfsdk:"ttl_seconds"`
+	StatusTtlSeconds            map[string]types.Int64 `tfsdk:"status_ttl_seconds"`
+	StaleWhileRevalidateSeconds types.Int64            `tfsdk:"stale_while_revalidate_seconds"`
+	StaleIfErrorSeconds         types.Int64            `tfsdk:"stale_if_error_seconds"`
+	RespectOriginHeaders        types.Bool             `tfsdk:"respect_origin_headers"`
+	PathRules                   *GlobMatcher           `tfsdk:"path_rules"`
+	QueryParameters             *QueryParametersModel  `tfsdk:"query_parameters"`
+	Bypass                      *CacheBypassModel      `tfsdk:"bypass"`
 }
 
 func (c *CacheModel) transformToDto() client.CacheDto {
 	var cacheBody = client.CacheDto{
-		TtlSeconds: c.TtlSeconds.ValueInt64Pointer(),
+		TtlSeconds:                  c.TtlSeconds.ValueInt64Pointer(),
+		StaleWhileRevalidateSeconds: c.StaleWhileRevalidateSeconds.ValueInt64Pointer(),
+		StaleIfErrorSeconds:         c.StaleIfErrorSeconds.ValueInt64Pointer(),
+		RespectOriginHeaders:        c.RespectOriginHeaders.ValueBoolPointer(),
+	}
+	if c.StatusTtlSeconds != nil {
+		cacheBody.StatusTtlSeconds = make(map[string]int64, len(c.StatusTtlSeconds))
+		for status, ttl := range c.StatusTtlSeconds {
+			cacheBody.StatusTtlSeconds[status] = ttl.ValueInt64()
+		}
 	}
 	if c.Keys != nil {
 		cacheBody.Keys = c.Keys.transformToDto()
@@ -95,13 +451,27 @@ func (c *CacheModel) transformToDto() client.CacheDto {
 	if c.PathRules != nil {
 		cacheBody.PathRules = c.PathRules.transformToDto()
 	}
+	if c.QueryParameters != nil {
+		cacheBody.QueryParameters = c.QueryParameters.transformToDto()
+	}
+	if c.Bypass != nil {
+		cacheBody.Bypass = c.Bypass.transformToDto()
+	}
 	return cacheBody
 }
 
 func trasformCacheModelToResourceModel(c *client.CacheDto) CacheModel {
-	var cacheModel = CacheModel{}
-	if c.TtlSeconds != nil {
-		cacheModel.TtlSeconds = types.Int64Value(*c.TtlSeconds)
+	var cacheModel = CacheModel{
+		TtlSeconds:                  types.Int64PointerValue(c.TtlSeconds),
+		StaleWhileRevalidateSeconds: types.Int64PointerValue(c.StaleWhileRevalidateSeconds),
+		StaleIfErrorSeconds:         types.Int64PointerValue(c.StaleIfErrorSeconds),
+		RespectOriginHeaders:        types.BoolPointerValue(c.RespectOriginHeaders),
+	}
+	if c.StatusTtlSeconds != nil {
+		cacheModel.StatusTtlSeconds = make(map[string]types.Int64, len(c.StatusTtlSeconds))
+		for status, ttl := range c.StatusTtlSeconds {
+			cacheModel.StatusTtlSeconds[status] = types.Int64Value(ttl)
+		}
 	}
 	if c.Keys != nil {
 		cacheModel.Keys = trasformCacheKeyModelToResourceModel(c.Keys)
@@ -109,95 +479,100 @@ func trasformCacheModelToResourceModel(c *client.CacheDto) CacheModel {
 	if c.PathRules != nil {
 		cacheModel.PathRules = trasformMatcherToResourceModel(c.PathRules)
 	}
+	if c.QueryParameters != nil {
+		cacheModel.QueryParameters = transformQueryParametersToResourceModel(c.QueryParameters)
+	}
+	if c.Bypass != nil {
+		cacheModel.Bypass = transformCacheBypassToResourceModel(c.Bypass)
+	}
 	return cacheModel
 }
 
-type GlobMatcher struct {
-	AnyMatch  []types.String `tfsdk:"any_match"`
-	NoneMatch []types.String `tfsdk:"none_match"`
+type QueryParametersModel struct {
+	Include UnorderedListValue `tfsdk:"include"`
+	Exclude UnorderedListValue `tfsdk:"exclude"`
+	Sort    types.Bool         `tfsdk:"sort"`
 }
 
-func (m *GlobMatcher) transformToDto() *client.MatcherDto {
-	var anyMatch = make([]string, len(m.AnyMatch))
-	var noneMatch = make([]string, len(m.NoneMatch))
-	for i, h := range m.AnyMatch {
-		anyMatch[i] = h.ValueString()
+func (q *QueryParametersModel) transformToDto() *client.QueryParametersDto {
+	var queryParametersBody = client.QueryParametersDto{
+		Sort: q.Sort.ValueBool(),
 	}
-	for i, c := range m.NoneMatch {
-		noneMatch[i] = c.ValueString()
+	if !q.Include.IsNull() {
+		queryParametersBody.Include = q.Include.ValueStrings()
 	}
-	return &client.MatcherDto{
-		AnyMatch:  anyMatch,
-		NoneMatch: noneMatch,
+	if !q.Exclude.IsNull() {
+		queryParametersBody.Exclude = q.Exclude.ValueStrings()
 	}
+	return &queryParametersBody
 }
 
-func trasformMatcherToResourceModel(m *client.MatcherDto) *GlobMatcher {
-	var anyMatch = make([]types.String, len(m.AnyMatch))
-	var noneMatch = make([]types.String, len(m.NoneMatch))
-	for i, h := range m.AnyMatch {
-		anyMatch[i] = types.StringValue(h)
+func transformQueryParametersToResourceModel(q *client.QueryParametersDto) *QueryParametersModel {
+	return &QueryParametersModel{
+		Include: NewUnorderedListValueOrNull(q.Include, false),
+		Exclude: NewUnorderedListValueOrNull(q.Exclude, false),
+		Sort:    types.BoolValue(q.Sort),
+	}
+}
+
+type CacheBypassModel struct {
+	Headers UnorderedListValue `tfsdk:"headers"`
+	Cookies UnorderedListValue `tfsdk:"cookies"`
+}
+
+func (b *CacheBypassModel) transformToDto() *client.CacheBypassDto {
+	var bypassBody = client.CacheBypassDto{}
+	if !b.Headers.IsNull() {
+		bypassBody.Headers = b.Headers.ValueStrings()
+	}
+	if !b.Cookies.IsNull() {
+		bypassBody.Cookies = b.Cookies.ValueStrings()
 	}
-	for i, c := range m.NoneMatch {
-		noneMatch[i] = types.StringValue(c)
+	return &bypassBody
+}
+
+func transformCacheBypassToResourceModel(b *client.CacheBypassDto) *CacheBypassModel {
+	return &CacheBypassModel{
+		Headers: NewUnorderedListValueOrNull(b.Headers, true),
+		Cookies: NewUnorderedListValueOrNull(b.Cookies, false),
 	}
+}
+
+type GlobMatcher struct {
+	AnyMatch  UnorderedListValue `tfsdk:"any_match"`
+	NoneMatch UnorderedListValue `tfsdk:"none_match"`
+}
+
+func (m *GlobMatcher) transformToDto() *client.MatcherDto {
+	return &client.MatcherDto{
+		AnyMatch:  m.AnyMatch.ValueStrings(),
+		NoneMatch: m.NoneMatch.ValueStrings(),
+	}
+}
+
+func trasformMatcherToResourceModel(m *client.MatcherDto) *GlobMatcher {
 	return &GlobMatcher{
-		AnyMatch:  anyMatch,
-		NoneMatch: noneMatch,
-	}
-}
-
-type ShieldLocation string
-
-const (
-	London        ShieldLocation = "London"
-	Manchester    ShieldLocation = "Manchester"
-	Frankfurt                    = "Frankfurt"
-	Madrid                       = "Madrid"
-	New_York_City                = "New York City"
-	Los_Angeles                  = "Los Angeles"
-	Toronto                      = "Toronto"
-	Johannesburg                 = "Johannesburg"
-	Seoul                        = "Seoul"
-	Sydney                       = "Sydney"
-	Tokyo                        = "Tokyo"
-	Hong_Kong                    = "Hong Kong"
-	Mumbai                       = "Mumbai"
-	Singapore                    = "Singapore"
-)
+		AnyMatch:  NewUnorderedListValue(m.AnyMatch, false),
+		NoneMatch: NewUnorderedListValue(m.NoneMatch, false),
+	}
+}
 
 type CacheKeyModel struct {
-	Headers []types.String `tfsdk:"headers"`
-	Cookies []types.String `tfsdk:"cookies"`
+	Headers UnorderedListValue `tfsdk:"headers"`
+	Cookies UnorderedListValue `tfsdk:"cookies"`
 }
 
 func (c *CacheKeyModel) transformToDto() *client.CacheKeyDto {
-	var cacheKeyHeaders = make([]string, len(c.Headers))
-	var cacheKeyCookies = make([]string, len(c.Cookies))
-	for i, h := range c.Headers {
-		cacheKeyHeaders[i] = h.ValueString()
-	}
-	for i, c := range c.Cookies {
-		cacheKeyCookies[i] = c.ValueString()
-	}
 	return &client.CacheKeyDto{
-		Header: cacheKeyHeaders,
-		Cookie: cacheKeyCookies,
+		Header: c.Headers.ValueStrings(),
+		Cookie: c.Cookies.ValueStrings(),
 	}
 }
 
 func trasformCacheKeyModelToResourceModel(c *client.CacheKeyDto) *CacheKeyModel {
-	var cacheKeyHeaders = make([]types.String, len(c.Header))
-	var cacheKeyCookies = make([]types.String, len(c.Cookie))
-	for i, h := range c.Header {
-		cacheKeyHeaders[i] = types.StringValue(h)
-	}
-	for i, c := range c.Cookie {
-		cacheKeyCookies[i] = types.StringValue(c)
-	}
 	return &CacheKeyModel{
-		Headers: cacheKeyHeaders,
-		Cookies: cacheKeyCookies,
+		Headers: NewUnorderedListValue(c.Header, true),
+		Cookies: NewUnorderedListValue(c.Cookie, false),
 	}
 }
 
@@ -206,6 +581,172 @@ type BasicAuthModel struct {
 	Password types.String `tfsdk:"password"`
 }
 
+type AccessControlModel struct {
+	IpAllowlist      UnorderedListValue `tfsdk:"ip_allowlist"`
+	IpDenylist       UnorderedListValue `tfsdk:"ip_denylist"`
+	CountryAllowlist UnorderedListValue `tfsdk:"country_allowlist"`
+	CountryDenylist  UnorderedListValue `tfsdk:"country_denylist"`
+	BypassToken      *BypassTokenModel  `tfsdk:"bypass_token"`
+}
+
+type BypassTokenModel struct {
+	Header types.String       `tfsdk:"header"`
+	Tokens UnorderedListValue `tfsdk:"tokens"`
+}
+
+func (a *AccessControlModel) transformToDto() *client.AccessControlDto {
+	var accessControlBody = client.AccessControlDto{}
+	if !a.IpAllowlist.IsNull() {
+		accessControlBody.IpAllowlist = a.IpAllowlist.ValueStrings()
+	}
+	if !a.IpDenylist.IsNull() {
+		accessControlBody.IpDenylist = a.IpDenylist.ValueStrings()
+	}
+	if !a.CountryAllowlist.IsNull() {
+		accessControlBody.CountryAllowlist = a.CountryAllowlist.ValueStrings()
+	}
+	if !a.CountryDenylist.IsNull() {
+		accessControlBody.CountryDenylist = a.CountryDenylist.ValueStrings()
+	}
+	if a.BypassToken != nil {
+		accessControlBody.BypassToken = &client.BypassTokenDto{
+			Header: a.BypassToken.Header.ValueString(),
+			Tokens: a.BypassToken.Tokens.ValueStrings(),
+		}
+	}
+	return &accessControlBody
+}
+
+func transformAccessControlToResourceModel(a *client.AccessControlDto) *AccessControlModel {
+	var accessControlModel = AccessControlModel{
+		IpAllowlist:      NewUnorderedListValueOrNull(a.IpAllowlist, false),
+		IpDenylist:       NewUnorderedListValueOrNull(a.IpDenylist, false),
+		CountryAllowlist: NewUnorderedListValueOrNull(a.CountryAllowlist, false),
+		CountryDenylist:  NewUnorderedListValueOrNull(a.CountryDenylist, false),
+	}
+	if a.BypassToken != nil {
+		accessControlModel.BypassToken = &BypassTokenModel{
+			Header: types.StringValue(a.BypassToken.Header),
+			Tokens: NewUnorderedListValue(a.BypassToken.Tokens, false),
+		}
+	}
+	return &accessControlModel
+}
+
+// validate reports access control blocks which are empty, and CIDR blocks which overlap.
+// Overlap between the allowlist and the denylist is ambiguous so is an error, whereas
+// overlap within one list is only redundant.
+func (a *AccessControlModel) validate(p path.Path, diags *diag.Diagnostics) {
+	if a.IpAllowlist.IsNull() && a.IpDenylist.IsNull() && a.CountryAllowlist.IsNull() && a.CountryDenylist.IsNull() && a.BypassToken == nil {
+		diags.AddAttributeError(
+			p,
+			"Missing Attribute Configuration",
+			"Expected at least one of `ip_allowlist`, `ip_denylist`, `country_allowlist`, `country_denylist` or `bypass_token` to be set inside the access control object.",
+		)
+	}
+
+	if a.IpAllowlist.IsUnknown() || a.IpDenylist.IsUnknown() {
+		return
+	}
+
+	allowlist := a.IpAllowlist.ValueStrings()
+	denylist := a.IpDenylist.ValueStrings()
+
+	for _, overlap := range overlappingCIDRs(allowlist, denylist, false) {
+		diags.AddAttributeError(
+			p.AtName("ip_denylist"),
+			"Overlapping CIDR Blocks",
+			fmt.Sprintf("The allowed CIDR block %s overlaps with the denied CIDR block %s. Remove the overlap so it is clear whether these addresses are permitted.", overlap[0], overlap[1]),
+		)
+	}
+	for _, overlap := range overlappingCIDRs(allowlist, allowlist, true) {
+		diags.AddAttributeWarning(
+			p.AtName("ip_allowlist"),
+			"Overlapping CIDR Blocks",
+			fmt.Sprintf("The CIDR blocks %s and %s overlap, so one of them is redundant.", overlap[0], overlap[1]),
+		)
+	}
+	for _, overlap := range overlappingCIDRs(denylist, denylist, true) {
+		diags.AddAttributeWarning(
+			p.AtName("ip_denylist"),
+			"Overlapping CIDR Blocks",
+			fmt.Sprintf("The CIDR blocks %s and %s overlap, so one of them is redundant.", overlap[0], overlap[1]),
+		)
+	}
+}
+
+// countryCodeRegex matches an ISO 3166-1 alpha-2 country code.
+var countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+func accessControlAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"ip_allowlist": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          NewUnorderedListType(false),
+				Optional:            true,
+				MarkdownDescription: "A list of IPv4 or IPv6 CIDR blocks which are permitted. Requests from any other address are rejected.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(cidrValidator{}),
+				},
+			},
+			"ip_denylist": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          NewUnorderedListType(false),
+				Optional:            true,
+				MarkdownDescription: "A list of IPv4 or IPv6 CIDR blocks which are rejected. These must not overlap with `ip_allowlist`.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(cidrValidator{}),
+				},
+			},
+			"country_allowlist": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          NewUnorderedListType(false),
+				Optional:            true,
+				MarkdownDescription: "A list of ISO 3166-1 alpha-2 country codes, such as `GB`, which are permitted. Requests from any other country are rejected. Cannot be used alongside `country_denylist`.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(countryCodeRegex, "must be an ISO 3166-1 alpha-2 country code")),
+					listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("country_denylist")),
+				},
+			},
+			"country_denylist": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          NewUnorderedListType(false),
+				Optional:            true,
+				MarkdownDescription: "A list of ISO 3166-1 alpha-2 country codes, such as `GB`, which are rejected. Cannot be used alongside `country_allowlist`.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(countryCodeRegex, "must be an ISO 3166-1 alpha-2 country code")),
+				},
+			},
+			"bypass_token": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "A header which, when sent with one of the given tokens, permits the request regardless of the other restrictions.",
+				Attributes: map[string]schema.Attribute{
+					"header": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The name of the header which holds the token.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name"),
+						},
+					},
+					"tokens": schema.ListAttribute{
+						ElementType:         types.StringType,
+						CustomType:          NewUnorderedListType(false),
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "A list of tokens which are accepted in the header.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 type ConditionalHeaderModel struct {
 	MatchingHeader types.String `tfsdk:"matching_header"`
 	Pattern        types.String `tfsdk:"pattern"`
@@ -236,6 +777,130 @@ func transformCondHeaderToResourceModel(c *client.ConditionalHeaderDto) Conditio
 	return condHeaderResourceModel
 }
 
+type HeaderRuleModel struct {
+	Route     types.String        `tfsdk:"route"`
+	PathRules *GlobMatcher        `tfsdk:"path_rules"`
+	Request   *HeaderActionsModel `tfsdk:"request"`
+	Response  *HeaderActionsModel `tfsdk:"response"`
+}
+
+func (h *HeaderRuleModel) transformToDto() client.HeaderRuleDto {
+	var headerRuleBody = client.HeaderRuleDto{
+		Route: h.Route.ValueString(),
+	}
+	if h.PathRules != nil {
+		headerRuleBody.PathRules = h.PathRules.transformToDto()
+	}
+	if h.Request != nil {
+		headerRuleBody.Request = h.Request.transformToDto()
+	}
+	if h.Response != nil {
+		headerRuleBody.Response = h.Response.transformToDto()
+	}
+	return headerRuleBody
+}
+
+func transformHeaderRuleToResourceModel(h *client.HeaderRuleDto) HeaderRuleModel {
+	var headerRuleModel = HeaderRuleModel{}
+	if h.Route != "" {
+		headerRuleModel.Route = types.StringValue(h.Route)
+	}
+	if h.PathRules != nil {
+		headerRuleModel.PathRules = trasformMatcherToResourceModel(h.PathRules)
+	}
+	if h.Request != nil {
+		headerRuleModel.Request = transformHeaderActionsToResourceModel(h.Request)
+	}
+	if h.Response != nil {
+		headerRuleModel.Response = transformHeaderActionsToResourceModel(h.Response)
+	}
+	return headerRuleModel
+}
+
+type HeaderActionsModel struct {
+	Set    map[string]types.String `tfsdk:"set"`
+	Add    map[string]types.String `tfsdk:"add"`
+	Remove UnorderedListValue      `tfsdk:"remove"`
+}
+
+func (h *HeaderActionsModel) transformToDto() *client.HeaderActionsDto {
+	var headerActionsBody = client.HeaderActionsDto{
+		Set: transformStringMapToDto(h.Set),
+		Add: transformStringMapToDto(h.Add),
+	}
+	if !h.Remove.IsNull() {
+		headerActionsBody.Remove = h.Remove.ValueStrings()
+	}
+	return &headerActionsBody
+}
+
+func transformHeaderActionsToResourceModel(h *client.HeaderActionsDto) *HeaderActionsModel {
+	return &HeaderActionsModel{
+		Set:    transformStringMapToResourceModel(h.Set),
+		Add:    transformStringMapToResourceModel(h.Add),
+		Remove: NewUnorderedListValueOrNull(h.Remove, true),
+	}
+}
+
+func transformStringMapToDto(m map[string]types.String) map[string]string {
+	if m == nil {
+		return nil
+	}
+	var dto = make(map[string]string, len(m))
+	for k, v := range m {
+		dto[k] = v.ValueString()
+	}
+	return dto
+}
+
+func transformStringMapToResourceModel(m map[string]string) map[string]types.String {
+	if m == nil {
+		return nil
+	}
+	var model = make(map[string]types.String, len(m))
+	for k, v := range m {
+		model[k] = types.StringValue(v)
+	}
+	return model
+}
+
+// headerNameRegex matches the characters permitted in a HTTP header name.
+var headerNameRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+func headerActionsAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"set": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "A map of header names to values. Each header is set to the value given, replacing any existing value.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name")),
+				},
+			},
+			"add": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "A map of header names to values. Each value is added to the header, keeping any existing values.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name")),
+				},
+			},
+			"remove": schema.ListAttribute{
+				ElementType:         types.StringType,
+				CustomType:          NewUnorderedListType(true),
+				Optional:            true,
+				MarkdownDescription: "A list of header names which are removed.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name")),
+				},
+			},
+		},
+	}
+}
+
 // Metadata implements resource.Resource.
 func (m *MTEConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mte_config"
@@ -256,6 +921,7 @@ func (m *MTEConfigResource) Configure(ctx context.Context, req resource.Configur
 	}
 
 	m.client = resourceData.client
+	m.defaults = resourceData.defaults
 }
 
 func (m *MTEConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -269,21 +935,89 @@ func (m *MTEConfigResource) ValidateConfig(ctx context.Context, req resource.Val
 
 	if data.Config.Cache != nil {
 		for _, c := range data.Config.Cache {
-			if c.Keys == nil && c.TtlSeconds == basetypes.NewInt64Null() {
+			if c.Keys == nil && c.QueryParameters == nil && c.Bypass == nil && c.TtlSeconds == basetypes.NewInt64Null() && c.StatusTtlSeconds == nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("cache"),
+					"Missing Attribute Configuration",
+					"Expected one of `keys`, `query_parameters`, `bypass`, `ttl_seconds` or `status_ttl_seconds` to be set inside the cache object.",
+				)
+			}
+			if c.Bypass != nil && c.Bypass.Headers.IsNull() && c.Bypass.Cookies.IsNull() {
 				resp.Diagnostics.AddAttributeError(
 					path.Root("cache"),
 					"Missing Attribute Configuration",
-					"Expected either `keys` or `ttl_seconds` to be set inside the cache object.",
+					"Expected either `headers` or `cookies` to be set inside the cache bypass object.",
 				)
 			}
 		}
 	}
+
+	if data.Config.AccessControl != nil {
+		data.Config.AccessControl.validate(path.Root("config").AtName("access_control"), &resp.Diagnostics)
+	}
+	for i, r := range data.Config.Routes {
+		r.validateOrigins(path.Root("config").AtName("routes").AtListIndex(i), &resp.Diagnostics)
+		if r.EnableSsl.Equal(types.BoolValue(false)) && (!r.SniHostname.IsNull() || !r.VerifyCertificate.IsNull()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config").AtName("routes").AtListIndex(i),
+				"Invalid Attribute Configuration",
+				"`sni_hostname` and `verify_certificate` can only be set on a route where `enable_ssl` is `true`.",
+			)
+		}
+		if r.AccessControl != nil {
+			r.AccessControl.validate(path.Root("config").AtName("routes").AtListIndex(i).AtName("access_control"), &resp.Diagnostics)
+		}
+		if h := r.HealthCheck; h != nil && !h.IntervalSeconds.IsNull() && !h.TimeoutMs.IsNull() && h.TimeoutMs.ValueInt64() >= h.IntervalSeconds.ValueInt64()*1000 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config").AtName("routes").AtListIndex(i).AtName("health_check").AtName("timeout_ms"),
+				"Invalid Attribute Configuration",
+				"Expected `timeout_ms` to be less than `interval_seconds` so that each check completes before the next begins.",
+			)
+		}
+	}
+
+	for _, s := range shadowedRoutes(data.Config.Routes) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("config").AtName("routes").AtListIndex(s[1]),
+			"Unreachable Route",
+			fmt.Sprintf("This route can never be matched as every request to %q that it would match is already matched by the route at index %d, which matches the same path or a prefix of it. "+
+				"Move it above that route or make the earlier route's `match` more specific.", data.Config.Routes[s[1]].Path.ValueString(), s[0]),
+		)
+	}
+
+	for i, h := range data.Config.Headers {
+		if h.Request == nil && h.Response == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config").AtName("headers").AtListIndex(i),
+				"Missing Attribute Configuration",
+				"Expected either `request` or `response` to be set inside the headers object.",
+			)
+		}
+		for _, actions := range []*HeaderActionsModel{h.Request, h.Response} {
+			if actions != nil && actions.Set == nil && actions.Add == nil && actions.Remove.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("config").AtName("headers").AtListIndex(i),
+					"Missing Attribute Configuration",
+					"Expected one of `set`, `add` or `remove` to be set inside the request and response objects.",
+				)
+			}
+		}
+		if !h.Route.IsNull() && !h.Route.IsUnknown() && !data.Config.hasRoute(h.Route.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config").AtName("headers").AtListIndex(i),
+				"Unknown Route",
+				fmt.Sprintf("The headers object refers to the route `%s`, but no route with this path is defined in `routes`.", h.Route.ValueString()),
+			)
+		}
+	}
 }
 
 // Schema implements resource.Resource.
 func (m *MTEConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A resource which defines the various routes and other environment-specific config for a specific environment.",
+		MarkdownDescription: "A resource which defines the various routes and other environment-specific config for a specific environment. " +
+			"Updates and deletions fail rather than overwrite the config if it has been changed outside of Terraform since it was last read.",
+		Version: 1,
 
 		Attributes: map[string]schema.Attribute{
 			"config": schema.SingleNestedAttribute{
@@ -294,8 +1028,108 @@ func (m *MTEConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"host": schema.StringAttribute{
-									Required:            true,
-									MarkdownDescription: "The downstream host MTE should direct to. This host should not contain the protocol or any slashes. A correct example would be docs.thgaltitude.com",
+									Optional: true,
+									MarkdownDescription: "The downstream host MTE should direct to. This host should not contain the protocol or any slashes. A correct example would be docs.thgaltitude.com. " +
+										"Exactly one of `host` or `origins` must be set.",
+								},
+								"origins": schema.ListNestedAttribute{
+									Optional: true,
+									MarkdownDescription: "A list of hosts which traffic to this route is split between, for example during a blue/green release. " +
+										"Changing the weights is applied in place without recreating the route. Exactly one of `host` or `origins` must be set.",
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"host": schema.StringAttribute{
+												Required:            true,
+												MarkdownDescription: "The downstream host. As with `host`, this should not contain the protocol or any slashes.",
+											},
+											"weight": schema.Int64Attribute{
+												Required:            true,
+												MarkdownDescription: "The percentage of traffic sent to this host. The weights of all origins in the route must sum to 100.",
+												Validators: []validator.Int64{
+													int64validator.Between(0, 100),
+												},
+											},
+										},
+									},
+								},
+								"match": schema.SingleNestedAttribute{
+									Optional: true,
+									MarkdownDescription: "Conditions, in addition to `path`, which a request must meet to be sent to this route. All conditions must be met. " +
+										"Requests which do not meet them fall through to the next route with a matching path.",
+									Attributes: map[string]schema.Attribute{
+										"methods": schema.ListAttribute{
+											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
+											Optional:            true,
+											MarkdownDescription: "A list of HTTP methods, such as `POST`, one of which the request must use.",
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+												listvalidator.ValueStringsAre(stringvalidator.OneOf("GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS")),
+											},
+										},
+										"headers": matchPredicatesAttribute("A list of request headers which must be present with a value matching the pattern, "+
+											"such as `x-app-version` matching `2`.",
+											stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name")),
+										"cookies":          matchPredicatesAttribute("A list of cookies which must be present with a value matching the pattern."),
+										"query_parameters": matchPredicatesAttribute("A list of query parameters which must be present with a value matching the pattern."),
+									},
+								},
+								"override_host": schema.StringAttribute{
+									Optional: true,
+									MarkdownDescription: "The value of the `Host` header sent to the host, for origins behind a load balancer which expects a different " +
+										"hostname to the one it is reached at. Defaults to the host being connected to.",
+								},
+								"sni_hostname": schema.StringAttribute{
+									Optional: true,
+									MarkdownDescription: "The hostname sent in the TLS Server Name Indication extension when connecting to the host. " +
+										"Defaults to `override_host` if set, otherwise the host being connected to. Requires `enable_ssl`.",
+								},
+								"port": schema.Int64Attribute{
+									Optional:            true,
+									MarkdownDescription: "The port to connect to the host on. Defaults to 443 when `enable_ssl` is `true`, otherwise 80.",
+									Validators: []validator.Int64{
+										int64validator.Between(1, 65535),
+									},
+								},
+								"verify_certificate": schema.BoolAttribute{
+									Optional: true,
+									MarkdownDescription: "A boolean specifying whether the certificate presented by the host is verified. Defaults to `true`. " +
+										"Disabling this should only be used for origins with self-signed certificates. Requires `enable_ssl`.",
+								},
+								"sticky_cookie": schema.StringAttribute{
+									Optional: true,
+									MarkdownDescription: "The name of a cookie used to remember which of the `origins` a client was assigned to, so that " +
+										"subsequent requests from the same client are sent to the same host.",
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(headerNameRegex, "must be a valid cookie name"),
+									},
+								},
+								"origin_overrides": schema.ListNestedAttribute{
+									Optional: true,
+									MarkdownDescription: "A list of rules which send a request to a specific one of the `origins` when it carries a given header value, " +
+										"such as `x-canary: 1`, regardless of the weights or `sticky_cookie`.",
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"header": schema.StringAttribute{
+												Required:            true,
+												MarkdownDescription: "The name of the request header to match.",
+												Validators: []validator.String{
+													stringvalidator.RegexMatches(headerNameRegex, "must be a valid HTTP header name"),
+												},
+											},
+											"value": schema.StringAttribute{
+												Required:            true,
+												MarkdownDescription: "The value the request header must equal.",
+											},
+											"host": schema.StringAttribute{
+												Required:            true,
+												MarkdownDescription: "The host, which must be one of the `origins`, that matching requests are sent to.",
+											},
+										},
+									},
 								},
 								"path": schema.StringAttribute{
 									Required:            true,
@@ -318,29 +1152,97 @@ func (m *MTEConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 								"shield_location": schema.StringAttribute{
 									Optional: true,
 									MarkdownDescription: "This describes the location which all requests will be forwarded to before reaching the origin " +
-										"of this route.",
-									Validators: []validator.String{
-										stringvalidator.OneOf([]string{string(London),
-											string(Manchester),
-											string(New_York_City),
-											string(Frankfurt),
-											string(Madrid),
-											string(Los_Angeles),
-											string(Toronto),
-											string(Johannesburg),
-											string(Seoul),
-											string(Sydney),
-											string(Tokyo),
-											string(Hong_Kong),
-											string(Mumbai),
-											string(Singapore),
-										}...,
-										),
+										"of this route. The available locations are listed by the `altitude_mte_shield_locations` data source.",
+								},
+								"access_control": accessControlAttribute("Restrictions on which clients may access this route. When set, these replace the " +
+									"`access_control` defined for the whole environment."),
+								"connect_timeout_ms": schema.Int64Attribute{
+									Optional:            true,
+									MarkdownDescription: "The time, in milliseconds, to wait for a connection to the host to be established. Must be between 1 and 60000.",
+									Validators: []validator.Int64{
+										int64validator.Between(1, 60000),
+									},
+								},
+								"first_byte_timeout_ms": schema.Int64Attribute{
+									Optional:            true,
+									MarkdownDescription: "The time, in milliseconds, to wait for the first byte of the response from the host once connected. Must be between 1 and 300000.",
+									Validators: []validator.Int64{
+										int64validator.Between(1, 300000),
+									},
+								},
+								"between_bytes_timeout_ms": schema.Int64Attribute{
+									Optional:            true,
+									MarkdownDescription: "The maximum time, in milliseconds, to wait between bytes of the response from the host. Must be between 1 and 300000.",
+									Validators: []validator.Int64{
+										int64validator.Between(1, 300000),
+									},
+								},
+								"retry_attempts": schema.Int64Attribute{
+									Optional: true,
+									MarkdownDescription: "The number of times a request is retried against the host if it fails to connect or times out. Only idempotent requests, " +
+										"such as `GET` and `HEAD`, are retried. Must be between 0 and 5.",
+									Validators: []validator.Int64{
+										int64validator.Between(0, 5),
+									},
+								},
+								"health_check": schema.SingleNestedAttribute{
+									Optional:            true,
+									MarkdownDescription: "A probe which periodically checks the health of the host. While the host is unhealthy, requests are sent to `fallback_hosts`.",
+									Attributes: map[string]schema.Attribute{
+										"path": schema.StringAttribute{
+											Required:            true,
+											MarkdownDescription: "The path on the host which is requested to check its health, such as `/healthcheck`.",
+											Validators: []validator.String{
+												stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with a slash"),
+											},
+										},
+										"interval_seconds": schema.Int64Attribute{
+											Optional:            true,
+											MarkdownDescription: "The time, in seconds, between each check. Must be between 5 and 300.",
+											Validators: []validator.Int64{
+												int64validator.Between(5, 300),
+											},
+										},
+										"timeout_ms": schema.Int64Attribute{
+											Optional:            true,
+											MarkdownDescription: "The time, in milliseconds, to wait for a response to a check before it is considered failed. Must be between 1 and 60000, and less than `interval_seconds`.",
+											Validators: []validator.Int64{
+												int64validator.Between(1, 60000),
+											},
+										},
+										"expected_status": schema.Int64Attribute{
+											Optional:            true,
+											MarkdownDescription: "The HTTP status the host must respond with for a check to pass.",
+											Validators: []validator.Int64{
+												int64validator.Between(100, 599),
+											},
+										},
+										"healthy_threshold": schema.Int64Attribute{
+											Optional:            true,
+											MarkdownDescription: "The number of consecutive passing checks before an unhealthy host is considered healthy. Must be between 1 and 10.",
+											Validators: []validator.Int64{
+												int64validator.Between(1, 10),
+											},
+										},
+										"unhealthy_threshold": schema.Int64Attribute{
+											Optional:            true,
+											MarkdownDescription: "The number of consecutive failing checks before a healthy host is considered unhealthy. Must be between 1 and 10.",
+											Validators: []validator.Int64{
+												int64validator.Between(1, 10),
+											},
+										},
+										"fallback_hosts": schema.ListAttribute{
+											ElementType:         types.StringType,
+											Optional:            true,
+											MarkdownDescription: "A list of hosts, in order of preference, which requests are sent to while the host is unhealthy. As with `host`, these should not contain the protocol or any slashes.",
+										},
 									},
 								},
 							},
 						},
 					},
+					"access_control": accessControlAttribute("Restrictions on which clients may access this environment, as an alternative to `basic_auth`. " +
+						"For example, limiting a pre-production environment to office IP ranges."),
 					"basic_auth": schema.SingleNestedAttribute{
 						Optional: true,
 						Attributes: map[string]schema.Attribute{
@@ -383,6 +1285,40 @@ func (m *MTEConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 							},
 						},
 					},
+					"headers": schema.ListNestedAttribute{
+						Optional: true,
+						MarkdownDescription: "A list of rules which add, set or remove static headers on requests sent to the origin and on responses " +
+							"sent to clients, such as security headers. A rule without `route` or `path_rules` applies to every path.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"route": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "The `path` of a route defined in `routes` which this rule is limited to.",
+								},
+								"path_rules": schema.SingleNestedAttribute{
+									Optional:            true,
+									MarkdownDescription: "A set of glob rules which identify when the header rule should be activated.",
+									Attributes: map[string]schema.Attribute{
+										"any_match": schema.ListAttribute{
+											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
+											Required:            true,
+											MarkdownDescription: "A list of glob paths where one of the list needs to match for the header rule to be activated for a path. If both this field and `none_match` are specified, both need to be successful for the path to match.",
+										},
+										"none_match": schema.ListAttribute{
+											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
+											Required:            true,
+											MarkdownDescription: "A list of glob paths where all of the list needs to not match the path for the header rule to be activated. If both this field and `any_match` are specified, both need to be successful for the path to match.",
+										},
+									},
+								},
+								"request": headerActionsAttribute("The header changes made to requests before they are sent to the origin."),
+								"response": headerActionsAttribute("The header changes made to responses before they are sent to the client. For example, " +
+									"setting `Strict-Transport-Security` or removing `Server`."),
+							},
+						},
+					},
 					"cache": schema.ListNestedAttribute{
 						Optional:            true,
 						MarkdownDescription: "A list of settings designed to manipulate your cache without requiring you to set response headers.",
@@ -394,11 +1330,13 @@ func (m *MTEConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 									Attributes: map[string]schema.Attribute{
 										"any_match": schema.ListAttribute{
 											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
 											Required:            true,
 											MarkdownDescription: "A list of glob paths where one of the list needs to match for the cache settings to be activated for a path. If both this field and `none_match` are specified, both need to be successful for the path to match.",
 										},
 										"none_match": schema.ListAttribute{
 											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
 											Required:            true,
 											MarkdownDescription: "A list of glob paths where all of the list needs to not match the path for the cache settings to be activated. If both this field and `any_match` are specified, both need to be successful for the path to match.",
 										},
@@ -409,13 +1347,15 @@ func (m *MTEConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 									Attributes: map[string]schema.Attribute{
 										"headers": schema.ListAttribute{
 											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(true),
 											Required:            true,
-											MarkdownDescription: "A list of header names of which the cache key will differeniate upon the values of these headers.",
+											MarkdownDescription: "A list of header names of which the cache key will differeniate upon the values of these headers. The ordering and casing of the header names is not significant.",
 										},
 										"cookies": schema.ListAttribute{
 											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
 											Required:            true,
-											MarkdownDescription: "A list of cookie names which the cache key will differeniate upon the values of these cookies.",
+											MarkdownDescription: "A list of cookie names which the cache key will differeniate upon the values of these cookies. The ordering of the cookie names is not significant.",
 										},
 									},
 									MarkdownDescription: "An object specifying header and cookie names which should be added to the cache key. The result " +
@@ -425,25 +1365,213 @@ func (m *MTEConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 									Optional:            true,
 									MarkdownDescription: "An integer that will be used to specify the time that the response of the route should be stored in the cache, in seconds.",
 								},
+								"status_ttl_seconds": schema.MapAttribute{
+									ElementType: types.Int64Type,
+									Optional:    true,
+									MarkdownDescription: "A map of HTTP response status codes to the time, in seconds, that responses with that status should be stored in the cache. " +
+										"For example `{ \"404\" = 60 }`. Statuses not in this map use `ttl_seconds`.",
+									Validators: []validator.Map{
+										mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[1-5][0-9]{2}$`), "must be a HTTP status code")),
+										mapvalidator.ValueInt64sAre(int64validator.AtLeast(0)),
+									},
+								},
+								"stale_while_revalidate_seconds": schema.Int64Attribute{
+									Optional:            true,
+									MarkdownDescription: "The time, in seconds, after a cached response has expired during which it may still be served while it is revalidated with the origin in the background. Must be between 0 and 604800 (7 days).",
+									Validators: []validator.Int64{
+										int64validator.Between(0, maxStaleWindowSeconds),
+									},
+								},
+								"stale_if_error_seconds": schema.Int64Attribute{
+									Optional:            true,
+									MarkdownDescription: "The time, in seconds, after a cached response has expired during which it may still be served if the origin returns an error or is unreachable. Must be between 0 and 604800 (7 days).",
+									Validators: []validator.Int64{
+										int64validator.Between(0, maxStaleWindowSeconds),
+									},
+								},
+								"respect_origin_headers": schema.BoolAttribute{
+									Optional:            true,
+									MarkdownDescription: "A boolean specifying whether caching headers returned by the origin, such as `Cache-Control`, take precedence over the TTLs set here.",
+								},
+								"query_parameters": schema.SingleNestedAttribute{
+									Optional: true,
+									MarkdownDescription: "An object specifying how the query string should be treated in the cache key. By default the whole " +
+										"query string is added to the cache key.",
+									Attributes: map[string]schema.Attribute{
+										"include": schema.ListAttribute{
+											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
+											Optional:            true,
+											MarkdownDescription: "A list of query parameter names which are the only parameters added to the cache key. Cannot be used alongside `exclude`.",
+											Validators: []validator.List{
+												listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("exclude")),
+											},
+										},
+										"exclude": schema.ListAttribute{
+											ElementType: types.StringType,
+											CustomType:  NewUnorderedListType(false),
+											Optional:    true,
+											MarkdownDescription: "A list of query parameter names which are removed from the cache key, such as tracking parameters. " +
+												"Glob patterns are supported, for example `utm_*`. Cannot be used alongside `include`.",
+										},
+										"sort": schema.BoolAttribute{
+											Optional:            true,
+											Computed:            true,
+											Default:             booldefault.StaticBool(false),
+											MarkdownDescription: "A boolean specifying whether the query parameters should be sorted before being added to the cache key, so that the ordering of parameters in a request does not produce separate cache entries. Defaults to `false`.",
+										},
+									},
+								},
+								"bypass": schema.SingleNestedAttribute{
+									Optional:            true,
+									MarkdownDescription: "An object specifying request conditions under which the cache is bypassed and the request is always sent to the origin.",
+									Attributes: map[string]schema.Attribute{
+										"headers": schema.ListAttribute{
+											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(true),
+											Optional:            true,
+											MarkdownDescription: "A list of header names where the presence of any of them on a request bypasses the cache.",
+										},
+										"cookies": schema.ListAttribute{
+											ElementType:         types.StringType,
+											CustomType:          NewUnorderedListType(false),
+											Optional:            true,
+											MarkdownDescription: "A list of cookie names where the presence of any of them on a request bypasses the cache, such as a session cookie.",
+										},
+									},
+								},
 							},
 						},
 					},
 				},
 			},
 			"environment_id": schema.StringAttribute{
-				Required: true,
-				MarkdownDescription: "The environment ID which this config associates with. If this value changes, this will " +
-					"replace this resource. **Note**, if this occurred on a production site, this would lead to downtime.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The environment ID which this config associates with. Defaults to the provider's `default_environment_id`. " +
+					"If this value changes, this will replace this resource. **Note**, if this occurred on a production site, this would lead to downtime.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Metadata, such as the owning team or cost centre, to label this config with. " +
+					"These take precedence over the provider's `default_tags`.",
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The tags applied to this config, being `tags` merged over the provider's `default_tags`.",
+			},
+			"rendered_payload": renderedPayloadAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (m *MTEConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	m.defaults.applyEnvironmentId(ctx, req, resp, true)
+
+	var data MTEConfigResourceModel
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), m.defaults.mergeTags(data.Tags))...)
+
+	if planAttributesKnown(resp.Plan, "config", "tags_all") {
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rendered_payload"), data.renderPayload())...)
+	}
+
+	if m.client != nil {
+		m.validateShieldLocations(ctx, &data, resp)
+	}
+}
+
+// validateShieldLocations checks each route's shield location against those supported by the Altitude API.
+// When the API cannot be reached the embedded list is used, and unrecognised locations are only warned
+// about as they may have been added since this version of the provider was released.
+func (m *MTEConfigResource) validateShieldLocations(ctx context.Context, data *MTEConfigResourceModel, resp *resource.ModifyPlanResponse) {
+	var configured bool
+	for _, r := range data.Config.Routes {
+		configured = configured || !r.ShieldLocation.IsNull()
+	}
+	if !configured {
+		return
+	}
+
+	shieldLocations, err := readShieldLocations(ctx, m.client)
+	for i, r := range data.Config.Routes {
+		if r.ShieldLocation.IsNull() || r.ShieldLocation.IsUnknown() ||
+			slices.Contains(shieldLocations, client.ShieldLocation(r.ShieldLocation.ValueString())) {
+			continue
+		}
+		p := path.Root("config").AtName("routes").AtListIndex(i).AtName("shield_location")
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				p,
+				"Unrecognised Shield Location",
+				fmt.Sprintf("The shield location %q is not in the list embedded in the provider and the Altitude API could not be reached to check it. "+
+					"It will be rejected when applied if it does not exist.\n\n%s", r.ShieldLocation.ValueString(), err.Error()),
+			)
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Invalid Shield Location",
+			fmt.Sprintf("The shield location %q does not exist. Use the `altitude_mte_shield_locations` data source to list the available locations.", r.ShieldLocation.ValueString()),
+		)
+	}
+}
+
 // ImportState implements resource.ResourceWithImportState.
 func (m *MTEConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	validateImportEnvironmentId(req.ID, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := m.client.ReadMTEConfig(ctx, client.ReadMTEConfigInput{EnvironmentId: req.ID})
+
+	var notFoundErr *client.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		resp.Diagnostics.AddError(
+			"Cannot Import Non-Existent MTE Config",
+			fmt.Sprintf("The environment %q has no config to import. Check the environment ID is correct, or remove the import "+
+				"and let Terraform create the config instead.", req.ID),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while checking the config exists:\n\n"+
+				err.Error(),
+		)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("environment_id"), req, resp)
 }
 
@@ -457,7 +1585,18 @@ func (m *MTEConfigResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	err := m.client.CreateMTEConfig(
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	etag, err := m.client.CreateMTEConfig(
+		ctx,
 		client.CreateMTEConfigInput{
 			Config:        data.transformToApiRequestBody(),
 			EnvironmentId: data.EnvironmentId.ValueString(),
@@ -473,7 +1612,9 @@ func (m *MTEConfigResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	data.RenderedPayload = data.renderPayload()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, etag)...)
 }
 
 // Delete implements resource.Resource.
@@ -486,12 +1627,37 @@ func (m *MTEConfigResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := m.client.DeleteMTEConfig(
+		ctx,
 		client.DeleteMTEConfigInput{
 			EnvironmentId: data.EnvironmentId.ValueString(),
+			ETag:          etag,
 		},
 	)
 
+	var preconditionErr *client.PreconditionFailedError
+	if errors.As(err, &preconditionErr) {
+		m.addConfigModifiedError(ctx, data.EnvironmentId.ValueString(), nil, &resp.Diagnostics)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Delete Resource",
@@ -509,7 +1675,18 @@ func (m *MTEConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	apiDto, err := m.client.ReadMTEConfig(
+		ctx,
 		client.ReadMTEConfigInput{
 			EnvironmentId: data.EnvironmentId.ValueString(),
 		},
@@ -527,8 +1704,15 @@ func (m *MTEConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	configModel := transformToResourceModel(apiDto)
 	data.Config = configModel
+	// tags_all is kept from state, as the tags returned by the API are not relied upon to round trip. They are
+	// only used when there are none in state, such as after an import.
+	if data.TagsAll.IsNull() {
+		data.TagsAll = tagsToMap(apiDto.Tags)
+	}
+	data.RenderedPayload = data.renderPayload()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, apiDto.ETag)...)
 }
 
 // Update implements resource.Resource.
@@ -541,13 +1725,39 @@ func (m *MTEConfigResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	err := m.client.UpdateMTEConfig(
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := getETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	config := plan.transformToApiRequestBody()
+	etag, err := m.client.UpdateMTEConfig(
+		ctx,
 		client.UpdateMTEConfigInput{
-			Config:        plan.transformToApiRequestBody(),
+			Config:        config,
 			EnvironmentId: plan.EnvironmentId.ValueString(),
+			ETag:          etag,
 		},
 	)
 
+	var preconditionErr *client.PreconditionFailedError
+	if errors.As(err, &preconditionErr) {
+		m.addConfigModifiedError(ctx, plan.EnvironmentId.ValueString(), &config, &resp.Diagnostics)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update MTE config",
@@ -556,7 +1766,21 @@ func (m *MTEConfigResource) Update(ctx context.Context, req resource.UpdateReque
 				"JSON Error: "+err.Error())
 		return
 	}
+	plan.RenderedPayload = plan.renderPayload()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setETag(ctx, resp.Private, etag)...)
+}
+
+// renderPayload renders the config as it is sent to the Altitude API, with secrets redacted.
+// renderPayload renders the config with its unordered lists made canonical, so that the payload does not
+// change when the Altitude API returns them reordered or with header names in a different case.
+func (m *MTEConfigResourceModel) renderPayload() types.String {
+	canonical := MTEConfigResourceModel{
+		Config:  canonicalUnorderedLists(m.Config),
+		TagsAll: m.TagsAll,
+	}
+	dto := canonical.transformToApiRequestBody()
+	return redactConfig(&dto)
 }
 
 func (m *MTEConfigResourceModel) transformToApiRequestBody() client.MTEConfigDto {
@@ -567,6 +1791,17 @@ func (m *MTEConfigResourceModel) transformToApiRequestBody() client.MTEConfigDto
 	dto := client.MTEConfigDto{
 		Routes: httpRoutes,
 	}
+	if !m.TagsAll.IsNull() {
+		dto.Tags = make(map[string]string, len(m.TagsAll.Elements()))
+		for k, v := range m.TagsAll.Elements() {
+			if s, ok := v.(types.String); ok {
+				dto.Tags[k] = s.ValueString()
+			}
+		}
+	}
+	if m.Config.AccessControl != nil {
+		dto.AccessControl = m.Config.AccessControl.transformToDto()
+	}
 	if m.Config.BasicAuth != nil {
 		dto.BasicAuth = &client.BasicAuthDto{
 			Username: m.Config.BasicAuth.Username.ValueString(),
@@ -589,6 +1824,14 @@ func (m *MTEConfigResourceModel) transformToApiRequestBody() client.MTEConfigDto
 		}
 		dto.Cache = httpCache
 	}
+
+	if len(m.Config.Headers) != 0 {
+		var headerRules = make([]client.HeaderRuleDto, len(m.Config.Headers))
+		for i, h := range m.Config.Headers {
+			headerRules[i] = h.transformToDto()
+		}
+		dto.Headers = headerRules
+	}
 	return dto
 }
 
@@ -601,6 +1844,9 @@ func transformToResourceModel(d *client.MTEConfigDto) MTEConfigModel {
 	model := MTEConfigModel{
 		Routes: routeModels,
 	}
+	if d.AccessControl != nil {
+		model.AccessControl = transformAccessControlToResourceModel(d.AccessControl)
+	}
 	if d.BasicAuth != nil {
 		model.BasicAuth = &BasicAuthModel{
 			Username: types.StringValue(d.BasicAuth.Username),
@@ -623,5 +1869,13 @@ func transformToResourceModel(d *client.MTEConfigDto) MTEConfigModel {
 		model.ConditionalHeaders = condHeadersModels
 	}
 
+	if len(d.Headers) != 0 {
+		var headerRuleModels = make([]HeaderRuleModel, len(d.Headers))
+		for i, h := range d.Headers {
+			headerRuleModels[i] = transformHeaderRuleToResourceModel(&h)
+		}
+		model.Headers = headerRuleModels
+	}
+
 	return model
 }
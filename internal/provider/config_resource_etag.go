@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-altitude/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// etagPrivateStateKey is the private state key holding the ETag of the config last read or written by
+// Terraform. It is sent as If-Match on updates and deletions so that changes made elsewhere, such as by
+// another pipeline or in the UI, are not silently overwritten.
+const etagPrivateStateKey = "etag"
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getETag returns the ETag stored in private state, which is empty for resources created before ETags were tracked.
+func getETag(ctx context.Context, private privateStateGetter) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, etagPrivateStateKey)
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
+	}
+
+	var etag string
+	if err := json.Unmarshal(value, &etag); err != nil {
+		diags.AddError("Invalid Private State", "The stored ETag could not be decoded: "+err.Error())
+	}
+	return etag, diags
+}
+
+// setETag stores an ETag returned by the Altitude API in private state.
+func setETag(ctx context.Context, private privateStateSetter, etag string) diag.Diagnostics {
+	value, _ := json.Marshal(etag)
+	return private.SetKey(ctx, etagPrivateStateKey, value)
+}
+
+// addConfigModifiedError explains that a write was rejected because the config changed outside of Terraform,
+// listing what changed when the config can be read again.
+func (m *MTEConfigResource) addConfigModifiedError(ctx context.Context, environmentId string, planned *client.MTEConfigDto, diags *diag.Diagnostics) {
+	detail := fmt.Sprintf("The config of environment %s was changed outside of Terraform after it was last read, "+
+		"so it has not been overwritten. Run terraform plan to refresh the config and review the changes before applying again.", environmentId)
+
+	live, err := m.client.ReadMTEConfig(ctx, client.ReadMTEConfigInput{EnvironmentId: environmentId})
+	if err == nil && planned != nil {
+		if drift := diffConfig(live, planned); drift.hasDrift() {
+			detail += "\n\nThe live config differs from the planned config as follows:\n" + drift.describe()
+		}
+	}
+
+	diags.AddError("MTE Config Modified Outside of Terraform", detail)
+}
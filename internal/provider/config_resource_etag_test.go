@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestETagPrivateState(t *testing.T) {
+	testCases := map[string]struct {
+		private  testPrivateState
+		expected string
+		isError  bool
+	}{
+		"unset": {
+			private: testPrivateState{},
+		},
+		"set": {
+			private:  testPrivateState{etagPrivateStateKey: []byte(`"\"abc123\""`)},
+			expected: `"abc123"`,
+		},
+		"invalid": {
+			private: testPrivateState{etagPrivateStateKey: []byte(`abc123`)},
+			isError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			etag, diags := getETag(context.Background(), tc.private)
+
+			if diags.HasError() != tc.isError {
+				t.Fatalf("expected error=%v, got diagnostics %v", tc.isError, diags)
+			}
+			if etag != tc.expected {
+				t.Errorf("expected ETag %q, got %q", tc.expected, etag)
+			}
+		})
+	}
+
+	t.Run("round-trip", func(t *testing.T) {
+		private := testPrivateState{}
+		setETag(context.Background(), private, `W/"v2"`)
+
+		etag, diags := getETag(context.Background(), private)
+		if diags.HasError() || etag != `W/"v2"` {
+			t.Errorf("expected ETag %q, got %q with diagnostics %v", `W/"v2"`, etag, diags)
+		}
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -112,6 +115,26 @@ func TestAccConfigWithCacheResource(t *testing.T) {
 					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.keys.headers.0", "foo"),
 				),
 			},
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_cache_query_parameters.tf", TEST_ENVIRONMENT_ID, INITIAL_HOST),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.query_parameters.exclude.0", "utm_*"),
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.query_parameters.sort", "true"),
+					resource.TestCheckNoResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.query_parameters.include"),
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.bypass.cookies.0", "session"),
+					resource.TestCheckNoResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.keys"),
+				),
+			},
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_cache_status_ttl.tf", TEST_ENVIRONMENT_ID, INITIAL_HOST),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.status_ttl_seconds.404", "60"),
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.stale_while_revalidate_seconds", "30"),
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.stale_if_error_seconds", "86400"),
+					resource.TestCheckResourceAttr("altitude_mte_config.cache-field-test", "config.cache.0.respect_origin_headers", "false"),
+				),
+			},
 		},
 	})
 }
@@ -157,6 +180,238 @@ func TestAccConfigWithConditionalHeadersCreateUpdateDelete(t *testing.T) {
 	})
 }
 
+func TestAccConfigWithHeadersCreateUpdateDelete(t *testing.T) {
+	var env_id = randomString(10)
+	var host = "www.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_headers_included.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.headers-test", "config.headers.0.response.set.X-Frame-Options", "DENY"),
+					resource.TestCheckResourceAttr("altitude_mte_config.headers-test", "config.headers.0.response.remove.0", "Server"),
+					resource.TestCheckResourceAttr("altitude_mte_config.headers-test", "config.headers.1.route", "/test"),
+					resource.TestCheckResourceAttr("altitude_mte_config.headers-test", "config.headers.1.request.add.X-Forwarded-Route", "test"),
+				),
+			},
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_headers_excluded.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("altitude_mte_config.headers-test", "config.headers"),
+					resource.TestCheckResourceAttr("altitude_mte_config.headers-test", "environment_id", env_id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigWithAccessControlResource(t *testing.T) {
+	var env_id = randomString(10)
+	var host = "www.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_access_control_included.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.access-control-test", "config.access_control.ip_allowlist.#", "2"),
+					resource.TestCheckResourceAttr("altitude_mte_config.access-control-test", "config.access_control.ip_denylist.0", "198.51.100.0/24"),
+					resource.TestCheckResourceAttr("altitude_mte_config.access-control-test", "config.access_control.bypass_token.header", "X-Bypass-Token"),
+					resource.TestCheckResourceAttr("altitude_mte_config.access-control-test", "config.routes.1.access_control.country_allowlist.0", "GB"),
+					resource.TestCheckNoResourceAttr("altitude_mte_config.access-control-test", "config.routes.0.access_control"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigWithOriginResilienceResource(t *testing.T) {
+	var env_id = randomString(10)
+	var host = "www.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_origin_resilience.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-resilience-test", "config.routes.0.connect_timeout_ms", "2000"),
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-resilience-test", "config.routes.0.retry_attempts", "2"),
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-resilience-test", "config.routes.0.health_check.path", "/healthcheck"),
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-resilience-test", "config.routes.0.health_check.fallback_hosts.0", "backup.thgaltitude.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigWithWeightedOriginsResource(t *testing.T) {
+	var env_id = randomString(10)
+	var host = "blue.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_weighted_origins_1.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.weighted-origins-test", "config.routes.0.origins.#", "2"),
+					resource.TestCheckResourceAttr("altitude_mte_config.weighted-origins-test", "config.routes.0.origins.1.weight", "10"),
+					resource.TestCheckResourceAttr("altitude_mte_config.weighted-origins-test", "config.routes.0.sticky_cookie", "altitude-origin"),
+					resource.TestCheckResourceAttr("altitude_mte_config.weighted-origins-test", "config.routes.0.origin_overrides.0.header", "x-canary"),
+					resource.TestCheckNoResourceAttr("altitude_mte_config.weighted-origins-test", "config.routes.0.host"),
+				),
+			},
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_weighted_origins_2.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.weighted-origins-test", "config.routes.0.origins.0.weight", "50"),
+					resource.TestCheckResourceAttr("altitude_mte_config.weighted-origins-test", "config.routes.0.origins.1.weight", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigWithOriginHostOverrideResource(t *testing.T) {
+	var env_id = randomString(10)
+	var host = "lb.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_origin_host_override.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-host-override-test", "config.routes.0.override_host", "origin.thgaltitude.com"),
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-host-override-test", "config.routes.0.sni_hostname", "origin.thgaltitude.com"),
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-host-override-test", "config.routes.0.port", "8443"),
+					resource.TestCheckResourceAttr("altitude_mte_config.origin-host-override-test", "config.routes.0.verify_certificate", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigWithRouteMatchResource(t *testing.T) {
+	var env_id = randomString(10)
+	var host = "api.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_route_match.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.route-match-test", "config.routes.0.match.methods.#", "2"),
+					resource.TestCheckResourceAttr("altitude_mte_config.route-match-test", "config.routes.0.match.headers.0.name", "x-app-version"),
+					resource.TestCheckNoResourceAttr("altitude_mte_config.route-match-test", "config.routes.1.match"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigWithProviderDefaultsResource(t *testing.T) {
+	var env_id = randomString(10)
+	var host = "www.thgaltitude.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVResource("testdata/altitude_mte_config_provider_defaults.tf", env_id, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altitude_mte_config.provider-defaults-test", "environment_id", env_id),
+					resource.TestCheckResourceAttr("altitude_mte_config.provider-defaults-test", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("altitude_mte_config.provider-defaults-test", "tags_all.team", "platform"),
+					resource.TestCheckResourceAttr("altitude_mte_config.provider-defaults-test", "tags_all.cost_centre", "123"),
+				),
+			},
+		},
+	})
+}
+
+func TestRouteValidateOrigins(t *testing.T) {
+	origins := func(weights ...int64) []WeightedOriginModel {
+		var o []WeightedOriginModel
+		for i, w := range weights {
+			o = append(o, WeightedOriginModel{Host: types.StringValue(fmt.Sprintf("origin-%d.thgaltitude.com", i)), Weight: types.Int64Value(w)})
+		}
+		return o
+	}
+	testCases := map[string]struct {
+		route         RouteModel
+		expectedError bool
+	}{
+		"host":                  {route: RouteModel{Host: types.StringValue("www.thgaltitude.com")}},
+		"unknown-host":          {route: RouteModel{Host: types.StringUnknown()}},
+		"origins":               {route: RouteModel{Origins: origins(90, 10)}},
+		"neither":               {route: RouteModel{}, expectedError: true},
+		"both":                  {route: RouteModel{Host: types.StringValue("www.thgaltitude.com"), Origins: origins(100)}, expectedError: true},
+		"weights-under":         {route: RouteModel{Origins: origins(50, 40)}, expectedError: true},
+		"weights-over":          {route: RouteModel{Origins: origins(60, 60)}, expectedError: true},
+		"sticky-without-origin": {route: RouteModel{Host: types.StringValue("www.thgaltitude.com"), StickyCookie: types.StringValue("origin")}, expectedError: true},
+		"override-known-origin": {
+			route: RouteModel{
+				Origins:         origins(90, 10),
+				OriginOverrides: []OriginOverrideModel{{Header: types.StringValue("x-canary"), Value: types.StringValue("1"), Host: types.StringValue("origin-1.thgaltitude.com")}},
+			},
+		},
+		"override-unknown-origin": {
+			route: RouteModel{
+				Origins:         origins(90, 10),
+				OriginOverrides: []OriginOverrideModel{{Header: types.StringValue("x-canary"), Value: types.StringValue("1"), Host: types.StringValue("www.thgaltitude.com")}},
+			},
+			expectedError: true,
+		},
+		"unknown-host-weights-over": {
+			route:         RouteModel{Host: types.StringUnknown(), Origins: origins(60, 60)},
+			expectedError: true,
+		},
+		"unknown-host-override-unknown-origin": {
+			route: RouteModel{
+				Host:            types.StringUnknown(),
+				Origins:         origins(90, 10),
+				OriginOverrides: []OriginOverrideModel{{Header: types.StringValue("x-canary"), Value: types.StringValue("1"), Host: types.StringValue("www.thgaltitude.com")}},
+			},
+			expectedError: true,
+		},
+		"unknown-weight": {
+			route: RouteModel{
+				Origins: []WeightedOriginModel{
+					{Host: types.StringValue("origin-0.thgaltitude.com"), Weight: types.Int64Unknown()},
+					{Host: types.StringValue("origin-1.thgaltitude.com"), Weight: types.Int64Value(10)},
+				},
+			},
+		},
+		"duplicate-origin": {
+			route: RouteModel{
+				Origins: []WeightedOriginModel{
+					{Host: types.StringValue("www.thgaltitude.com"), Weight: types.Int64Value(50)},
+					{Host: types.StringValue("www.thgaltitude.com"), Weight: types.Int64Value(50)},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			testCase.route.validateOrigins(path.Root("route"), &diags)
+
+			if diags.HasError() != testCase.expectedError {
+				t.Errorf("expected error to be %t, got diagnostics: %v", testCase.expectedError, diags)
+			}
+		})
+	}
+}
+
 func testAccKVResource(fileResource string, environmentId string, host string) string {
 	b, err := os.ReadFile(fileResource)
 	if err != nil {
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState implements resource.ResourceWithUpgradeState.
+//
+// Each prior schema version is kept alongside its own model types so that changes to the
+// current model never affect how older state is decoded.
+func (m *MTEConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := mteConfigSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeMTEConfigStateFromV0,
+		},
+	}
+}
+
+// Version 0 of the schema predates the `timeouts` block.
+type MTEConfigResourceModelV0 struct {
+	EnvironmentId types.String     `tfsdk:"environment_id"`
+	Config        MTEConfigModelV0 `tfsdk:"config"`
+}
+
+type MTEConfigModelV0 struct {
+	Routes             []RouteModelV0             `tfsdk:"routes"`
+	BasicAuth          *BasicAuthModelV0          `tfsdk:"basic_auth"`
+	ConditionalHeaders []ConditionalHeaderModelV0 `tfsdk:"conditional_headers"`
+	Cache              []CacheModelV0             `tfsdk:"cache"`
+}
+
+type RouteModelV0 struct {
+	Host               types.String `tfsdk:"host"`
+	Path               types.String `tfsdk:"path"`
+	EnableSsl          types.Bool   `tfsdk:"enable_ssl"`
+	PreservePathPrefix types.Bool   `tfsdk:"preserve_path_prefix"`
+	AppendPathPrefix   types.String `tfsdk:"append_path_prefix"`
+	ShieldLocation     types.String `tfsdk:"shield_location"`
+}
+
+type BasicAuthModelV0 struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type ConditionalHeaderModelV0 struct {
+	MatchingHeader types.String `tfsdk:"matching_header"`
+	Pattern        types.String `tfsdk:"pattern"`
+	NewHeader      types.String `tfsdk:"new_header"`
+	MatchValue     types.String `tfsdk:"match_value"`
+	NoMatchValue   types.String `tfsdk:"no_match_value"`
+}
+
+type CacheModelV0 struct {
+	Keys       *CacheKeyModelV0 `tfsdk:"keys"`
+	TtlSeconds types.Int64      `tfsdk:"ttl_seconds"`
+	PathRules  *GlobMatcherV0   `tfsdk:"path_rules"`
+}
+
+type CacheKeyModelV0 struct {
+	Headers []types.String `tfsdk:"headers"`
+	Cookies []types.String `tfsdk:"cookies"`
+}
+
+type GlobMatcherV0 struct {
+	AnyMatch  []types.String `tfsdk:"any_match"`
+	NoneMatch []types.String `tfsdk:"none_match"`
+}
+
+func mteConfigSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"config": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"routes": schema.ListNestedAttribute{
+						Required: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"host":                 schema.StringAttribute{Required: true},
+								"path":                 schema.StringAttribute{Required: true},
+								"enable_ssl":           schema.BoolAttribute{Required: true},
+								"preserve_path_prefix": schema.BoolAttribute{Required: true},
+								"append_path_prefix":   schema.StringAttribute{Optional: true},
+								"shield_location":      schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"basic_auth": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"username": schema.StringAttribute{Required: true},
+							"password": schema.StringAttribute{Required: true},
+						},
+					},
+					"conditional_headers": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"matching_header": schema.StringAttribute{Required: true},
+								"pattern":         schema.StringAttribute{Required: true},
+								"new_header":      schema.StringAttribute{Required: true},
+								"match_value":     schema.StringAttribute{Required: true},
+								"no_match_value":  schema.StringAttribute{Required: true},
+							},
+						},
+					},
+					"cache": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"path_rules": schema.SingleNestedAttribute{
+									Optional: true,
+									Attributes: map[string]schema.Attribute{
+										"any_match":  schema.ListAttribute{ElementType: types.StringType, Required: true},
+										"none_match": schema.ListAttribute{ElementType: types.StringType, Required: true},
+									},
+								},
+								"keys": schema.SingleNestedAttribute{
+									Optional: true,
+									Attributes: map[string]schema.Attribute{
+										"headers": schema.ListAttribute{ElementType: types.StringType, Required: true},
+										"cookies": schema.ListAttribute{ElementType: types.StringType, Required: true},
+									},
+								},
+								"ttl_seconds": schema.Int64Attribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+			"environment_id": schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func upgradeMTEConfigStateFromV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorState MTEConfigResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), priorState.EnvironmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), priorState.Config.upgrade())...)
+}
+
+func (c *MTEConfigModelV0) upgrade() MTEConfigModel {
+	var routes = make([]RouteModel, len(c.Routes))
+	for i, r := range c.Routes {
+		routes[i] = RouteModel{
+			Host:               r.Host,
+			Path:               r.Path,
+			EnableSsl:          r.EnableSsl,
+			PreservePathPrefix: r.PreservePathPrefix,
+			AppendPathPrefix:   r.AppendPathPrefix,
+			ShieldLocation:     r.ShieldLocation,
+		}
+	}
+
+	model := MTEConfigModel{
+		Routes: routes,
+	}
+
+	if c.BasicAuth != nil {
+		model.BasicAuth = &BasicAuthModel{
+			Username: c.BasicAuth.Username,
+			Password: c.BasicAuth.Password,
+		}
+	}
+
+	if c.ConditionalHeaders != nil {
+		model.ConditionalHeaders = make([]ConditionalHeaderModel, len(c.ConditionalHeaders))
+		for i, h := range c.ConditionalHeaders {
+			model.ConditionalHeaders[i] = ConditionalHeaderModel{
+				MatchingHeader: h.MatchingHeader,
+				Pattern:        h.Pattern,
+				NewHeader:      h.NewHeader,
+				MatchValue:     h.MatchValue,
+				NoMatchValue:   h.NoMatchValue,
+			}
+		}
+	}
+
+	if c.Cache != nil {
+		model.Cache = make([]CacheModel, len(c.Cache))
+		for i, cache := range c.Cache {
+			cacheModel := CacheModel{
+				TtlSeconds: cache.TtlSeconds,
+			}
+			if cache.Keys != nil {
+				cacheModel.Keys = &CacheKeyModel{
+					Headers: upgradeToUnorderedList(cache.Keys.Headers, true),
+					Cookies: upgradeToUnorderedList(cache.Keys.Cookies, false),
+				}
+			}
+			if cache.PathRules != nil {
+				cacheModel.PathRules = &GlobMatcher{
+					AnyMatch:  upgradeToUnorderedList(cache.PathRules.AnyMatch, false),
+					NoneMatch: upgradeToUnorderedList(cache.PathRules.NoneMatch, false),
+				}
+			}
+			model.Cache[i] = cacheModel
+		}
+	}
+
+	return model
+}
+
+func upgradeToUnorderedList(values []types.String, ignoreCase bool) UnorderedListValue {
+	var strings = make([]string, len(values))
+	for i, v := range values {
+		strings[i] = v.ValueString()
+	}
+	return NewUnorderedListValue(strings, ignoreCase)
+}
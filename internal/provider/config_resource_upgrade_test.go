@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMTEConfigResourceUpgradeStateFromV0(t *testing.T) {
+	ctx := context.Background()
+	r := &MTEConfigResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("expected a state upgrader for version 0")
+	}
+
+	rawState, err := os.ReadFile("testdata/state/altitude_mte_config_v0.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	priorValue, err := tftypes.ValueFromJSON(rawState, upgrader.PriorSchema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Raw:    priorValue,
+			Schema: *upgrader.PriorSchema,
+		},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			Schema: schemaResp.Schema,
+		},
+	}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var upgraded MTEConfigResourceModel
+	if diags := resp.State.Get(ctx, &upgraded); diags.HasError() {
+		t.Fatalf("unable to read upgraded state: %v", diags)
+	}
+
+	if upgraded.EnvironmentId != types.StringValue("terraform-acc-test-upgrade") {
+		t.Errorf("unexpected environment_id %s", upgraded.EnvironmentId)
+	}
+	if !upgraded.Timeouts.IsNull() {
+		t.Errorf("expected timeouts to be null, got %s", upgraded.Timeouts)
+	}
+
+	if len(upgraded.Config.Routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(upgraded.Config.Routes))
+	}
+	if upgraded.Config.Routes[0].ShieldLocation != types.StringValue("London") {
+		t.Errorf("unexpected shield_location %s", upgraded.Config.Routes[0].ShieldLocation)
+	}
+	if !upgraded.Config.Routes[0].AppendPathPrefix.IsNull() {
+		t.Errorf("expected append_path_prefix to be null, got %s", upgraded.Config.Routes[0].AppendPathPrefix)
+	}
+	if upgraded.Config.Routes[1].AppendPathPrefix != types.StringValue("foo") {
+		t.Errorf("unexpected append_path_prefix %s", upgraded.Config.Routes[1].AppendPathPrefix)
+	}
+
+	if upgraded.Config.BasicAuth == nil || upgraded.Config.BasicAuth.Username != types.StringValue("foobar") {
+		t.Errorf("unexpected basic_auth %v", upgraded.Config.BasicAuth)
+	}
+	if upgraded.Config.ConditionalHeaders != nil {
+		t.Errorf("expected conditional_headers to be null, got %v", upgraded.Config.ConditionalHeaders)
+	}
+
+	if len(upgraded.Config.Cache) != 1 {
+		t.Fatalf("expected 1 cache rule, got %d", len(upgraded.Config.Cache))
+	}
+	cache := upgraded.Config.Cache[0]
+	if cache.TtlSeconds != types.Int64Value(100) {
+		t.Errorf("unexpected ttl_seconds %s", cache.TtlSeconds)
+	}
+	if cache.Keys == nil || !slices.Equal(cache.Keys.Headers.ValueStrings(), []string{"foo"}) {
+		t.Errorf("unexpected cache keys %v", cache.Keys)
+	}
+	if cache.PathRules == nil || !slices.Equal(cache.PathRules.NoneMatch.ValueStrings(), []string{"/test/foo**"}) {
+		t.Errorf("unexpected path_rules %v", cache.PathRules)
+	}
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"terraform-provider-altitude/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ConfigDriftDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConfigDriftDataSource{}
+)
+
+// NewConfigDriftDataSource is a helper function to simplify the provider implementation.
+func NewConfigDriftDataSource() datasource.DataSource {
+	return &ConfigDriftDataSource{}
+}
+
+// ConfigDriftDataSource is the data source implementation.
+type ConfigDriftDataSource struct {
+	client *client.Client
+}
+
+// ConfigDriftDataSourceModel maps the data source schema data.
+type ConfigDriftDataSourceModel struct {
+	EnvironmentId   types.String      `tfsdk:"environment_id"`
+	DesiredConfig   types.String      `tfsdk:"desired_config"`
+	HasDrift        types.Bool        `tfsdk:"has_drift"`
+	Routes          *ConfigDriftModel `tfsdk:"routes"`
+	Cache           *ConfigDriftModel `tfsdk:"cache"`
+	Headers         *ConfigDriftModel `tfsdk:"headers"`
+	ChangedSettings []types.String    `tfsdk:"changed_settings"`
+}
+
+// ConfigDriftModel lists the items of a part of the config which differ. Routes are identified by
+// their path, and cache and header rules by their index.
+type ConfigDriftModel struct {
+	Added   []types.String `tfsdk:"added"`
+	Removed []types.String `tfsdk:"removed"`
+	Changed []types.String `tfsdk:"changed"`
+}
+
+// configDrift is the difference between the live config of an environment and the desired config.
+type configDrift struct {
+	routes          listDrift
+	cache           listDrift
+	headers         listDrift
+	changedSettings []string
+}
+
+// listDrift holds the keys of items which are only live (added), only desired (removed), or differ between the two (changed).
+type listDrift struct {
+	added   []string
+	removed []string
+	changed []string
+}
+
+func (d listDrift) isEmpty() bool {
+	return len(d.added) == 0 && len(d.removed) == 0 && len(d.changed) == 0
+}
+
+func (d configDrift) hasDrift() bool {
+	return !d.routes.isEmpty() || !d.cache.isEmpty() || !d.headers.isEmpty() || len(d.changedSettings) > 0
+}
+
+// describe lists the differences for use in diagnostics, one per line.
+func (d configDrift) describe() string {
+	var lines []string
+	for _, l := range []struct {
+		name  string
+		drift listDrift
+	}{{"routes", d.routes}, {"cache rules", d.cache}, {"header rules", d.headers}} {
+		if len(l.drift.added) > 0 {
+			lines = append(lines, fmt.Sprintf("- %s added: %s", l.name, strings.Join(l.drift.added, ", ")))
+		}
+		if len(l.drift.removed) > 0 {
+			lines = append(lines, fmt.Sprintf("- %s removed: %s", l.name, strings.Join(l.drift.removed, ", ")))
+		}
+		if len(l.drift.changed) > 0 {
+			lines = append(lines, fmt.Sprintf("- %s changed: %s", l.name, strings.Join(l.drift.changed, ", ")))
+		}
+	}
+	if len(d.changedSettings) > 0 {
+		lines = append(lines, "- settings changed: "+strings.Join(d.changedSettings, ", "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (d listDrift) transformToDataSourceModel() *ConfigDriftModel {
+	toStrings := func(values []string) []types.String {
+		s := make([]types.String, len(values))
+		for i, v := range values {
+			s[i] = types.StringValue(v)
+		}
+		return s
+	}
+	return &ConfigDriftModel{
+		Added:   toStrings(d.added),
+		Removed: toStrings(d.removed),
+		Changed: toStrings(d.changed),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ConfigDriftDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	resourceData, ok := req.ProviderData.(*ConfiguredData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ConfiguredData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = resourceData.client
+}
+
+// Metadata returns the data source type name.
+func (d *ConfigDriftDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mte_config_drift"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConfigDriftDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	driftAttribute := func(desc string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: desc,
+			Attributes: map[string]schema.Attribute{
+				"added": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Computed:            true,
+					MarkdownDescription: "Items which are live but not in `desired_config`.",
+				},
+				"removed": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Computed:            true,
+					MarkdownDescription: "Items which are in `desired_config` but not live.",
+				},
+				"changed": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Computed:            true,
+					MarkdownDescription: "Items which are both live and in `desired_config`, but differ.",
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Compares the live config of an environment with a desired config, such as to detect changes made " +
+			"outside of Terraform in a `check` block.",
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The environment ID whose live config is compared.",
+			},
+			"desired_config": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The desired config, JSON encoded in the same shape as the `config` attribute of `altitude_mte_config`. " +
+					"For example, `jsonencode(altitude_mte_config.example.config)`.",
+			},
+			"has_drift": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "A boolean which is `true` when the live config differs from `desired_config`.",
+			},
+			"routes":  driftAttribute("The routes which differ, identified by their `path`."),
+			"cache":   driftAttribute("The cache rules which differ, identified by their index."),
+			"headers": driftAttribute("The header rules which differ, identified by their index."),
+			"changed_settings": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				MarkdownDescription: "The other settings which differ, such as `basic_auth`, `access_control` or `conditional_headers`. " +
+					"Secret values are compared but never shown.",
+			},
+		},
+	}
+}
+
+// Read refreshes the terraform state with the latest data.
+func (d *ConfigDriftDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ConfigDriftDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := decodeConfigJSON(ctx, []byte(state.DesiredConfig.ValueString()))
+	if diags.HasError() {
+		for _, d := range diags {
+			resp.Diagnostics.AddAttributeError(path.Root("desired_config"), d.Summary(), d.Detail())
+		}
+		return
+	}
+
+	live, err := d.client.ReadMTEConfig(ctx, client.ReadMTEConfigInput{
+		EnvironmentId: state.EnvironmentId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get MTE config from Altitude provider",
+			err.Error(),
+		)
+		return
+	}
+
+	desiredDto := (&MTEConfigResourceModel{Config: *desired}).transformToApiRequestBody()
+	drift := diffConfig(live, &desiredDto)
+
+	state.Routes = drift.routes.transformToDataSourceModel()
+	state.Cache = drift.cache.transformToDataSourceModel()
+	state.Headers = drift.headers.transformToDataSourceModel()
+	state.ChangedSettings = make([]types.String, len(drift.changedSettings))
+	for i, s := range drift.changedSettings {
+		state.ChangedSettings[i] = types.StringValue(s)
+	}
+	state.HasDrift = types.BoolValue(drift.hasDrift())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// decodeConfigJSON decodes JSON in the shape of the `config` attribute of altitude_mte_config into its model.
+func decodeConfigJSON(ctx context.Context, data []byte) (*MTEConfigModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var schemaResp resource.SchemaResponse
+	(&MTEConfigResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	resourceType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config, err := tftypes.ValueFromJSON(data, resourceType.AttributeTypes["config"])
+	if err != nil {
+		diags.AddError("Invalid MTE Config", "The config could not be decoded: "+err.Error())
+		return nil, diags
+	}
+
+	values := make(map[string]tftypes.Value, len(resourceType.AttributeTypes))
+	for k, t := range resourceType.AttributeTypes {
+		values[k] = tftypes.NewValue(t, nil)
+	}
+	values["config"] = config
+
+	var model MTEConfigResourceModel
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(resourceType, values),
+	}
+	diags.Append(state.GetAttribute(ctx, path.Root("config"), &model.Config)...)
+	return &model.Config, diags
+}
+
+// diffConfig compares the live config of an environment with the desired config. Unordered lists, such as
+// cache key headers, are compared without regard to order, and header names without regard to case.
+func diffConfig(live *client.MTEConfigDto, desired *client.MTEConfigDto) configDrift {
+	var drift configDrift
+	live, desired = canonicalConfigDto(live), canonicalConfigDto(desired)
+
+	routeKey := func(r client.RouteDto) string { return r.Path }
+	drift.routes = diffKeyed(live.Routes, desired.Routes, routeKey)
+	drift.cache = diffIndexed(live.Cache, desired.Cache)
+	drift.headers = diffIndexed(live.Headers, desired.Headers)
+
+	settings := []struct {
+		name          string
+		live, desired any
+	}{
+		{"access_control", live.AccessControl, desired.AccessControl},
+		{"basic_auth", live.BasicAuth, desired.BasicAuth},
+		{"conditional_headers", live.ConditionalHeaders, desired.ConditionalHeaders},
+	}
+	for _, s := range settings {
+		if !jsonEqual(s.live, s.desired) {
+			drift.changedSettings = append(drift.changedSettings, s.name)
+		}
+	}
+
+	return drift
+}
+
+// canonicalConfigDto returns a copy of a config with its unordered lists normalised in the same way as
+// UnorderedListType, so that configs which are semantically equal are also equal as JSON.
+func canonicalConfigDto(dto *client.MTEConfigDto) *client.MTEConfigDto {
+	canonical := (&MTEConfigResourceModel{
+		Config:  canonicalUnorderedLists(transformToResourceModel(dto)),
+		TagsAll: tagsToMap(dto.Tags),
+	}).transformToApiRequestBody()
+	return &canonical
+}
+
+// diffKeyed compares two lists whose items are identified by key.
+func diffKeyed[T any](live []T, desired []T, key func(T) string) listDrift {
+	var drift listDrift
+	desiredByKey := make(map[string]T, len(desired))
+	for _, d := range desired {
+		desiredByKey[key(d)] = d
+	}
+	liveKeys := make(map[string]bool, len(live))
+	for _, l := range live {
+		k := key(l)
+		liveKeys[k] = true
+		if d, ok := desiredByKey[k]; !ok {
+			drift.added = append(drift.added, k)
+		} else if !jsonEqual(l, d) {
+			drift.changed = append(drift.changed, k)
+		}
+	}
+	for _, d := range desired {
+		if !liveKeys[key(d)] {
+			drift.removed = append(drift.removed, key(d))
+		}
+	}
+	slices.Sort(drift.added)
+	slices.Sort(drift.removed)
+	slices.Sort(drift.changed)
+	return drift
+}
+
+// diffIndexed compares two lists whose items are identified by their position.
+func diffIndexed[T any](live []T, desired []T) listDrift {
+	var drift listDrift
+	for i := 0; i < max(len(live), len(desired)); i++ {
+		switch {
+		case i >= len(desired):
+			drift.added = append(drift.added, fmt.Sprint(i))
+		case i >= len(live):
+			drift.removed = append(drift.removed, fmt.Sprint(i))
+		case !jsonEqual(live[i], desired[i]):
+			drift.changed = append(drift.changed, fmt.Sprint(i))
+		}
+	}
+	return drift
+}
+
+// jsonEqual reports whether a and b are sent to the Altitude API identically, so that empty and
+// omitted values are treated the same.
+func jsonEqual(a, b any) bool {
+	var aValue, bValue any
+	aBody, _ := json.Marshal(a)
+	bBody, _ := json.Marshal(b)
+	_ = json.Unmarshal(aBody, &aValue)
+	_ = json.Unmarshal(bBody, &bValue)
+	return reflect.DeepEqual(aValue, bValue)
+}
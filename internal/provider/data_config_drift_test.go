@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-altitude/internal/provider/client"
+)
+
+func TestAccConfigDriftDataSource(t *testing.T) {
+	var env_id = randomString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "altitude_mte_config" "test" {
+  environment_id = %[1]q
+  config = {
+    routes = [
+      {
+        host                 = "www.thgaltitude.com"
+        path                 = "/"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+  }
+}
+
+data "altitude_mte_config_drift" "test" {
+  environment_id = altitude_mte_config.test.environment_id
+  desired_config = jsonencode({
+    routes = [
+      {
+        host                 = "www.thgaltitude.com"
+        path                 = "/"
+        enable_ssl           = false
+        preserve_path_prefix = true
+      },
+      {
+        host                 = "docs.thgaltitude.com"
+        path                 = "/docs"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+  })
+}
+`, env_id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altitude_mte_config_drift.test", "has_drift", "true"),
+					resource.TestCheckResourceAttr("data.altitude_mte_config_drift.test", "routes.changed.0", "/"),
+					resource.TestCheckResourceAttr("data.altitude_mte_config_drift.test", "routes.removed.0", "/docs"),
+					resource.TestCheckResourceAttr("data.altitude_mte_config_drift.test", "routes.added.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDecodeConfigJSON(t *testing.T) {
+	config, diags := decodeConfigJSON(context.Background(), []byte(`{
+		"routes": [{"host": "www.thgaltitude.com", "path": "/", "enable_ssl": true, "preserve_path_prefix": false}],
+		"cache": [{"keys": {"headers": ["Accept-Language"]}, "ttl_seconds": 60}]
+	}`))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(config.Routes) != 1 || config.Routes[0].Host.ValueString() != "www.thgaltitude.com" || !config.Routes[0].ShieldLocation.IsNull() {
+		t.Errorf("unexpected routes %+v", config.Routes)
+	}
+	if len(config.Cache) != 1 || config.Cache[0].TtlSeconds.ValueInt64() != 60 || config.Cache[0].Keys.Headers.ValueStrings()[0] != "Accept-Language" {
+		t.Errorf("unexpected cache %+v", config.Cache)
+	}
+
+	if _, diags := decodeConfigJSON(context.Background(), []byte(`{"unknown": true}`)); !diags.HasError() {
+		t.Error("expected an error for an unknown attribute")
+	}
+}
+
+func TestDiffConfig(t *testing.T) {
+	live := &client.MTEConfigDto{
+		Routes: []client.RouteDto{
+			{Host: "www.thgaltitude.com", Path: "/"},
+			{Host: "docs.thgaltitude.com", Path: "/docs"},
+			{Host: "api.thgaltitude.com", Path: "/api"},
+		},
+		Cache:     []client.CacheDto{{Keys: &client.CacheKeyDto{Header: []string{"Accept"}}}},
+		BasicAuth: &client.BasicAuthDto{Username: "user", Password: "changed"},
+	}
+	desired := &client.MTEConfigDto{
+		Routes: []client.RouteDto{
+			{Host: "www.thgaltitude.com", Path: "/"},
+			{Host: "docs-v2.thgaltitude.com", Path: "/docs"},
+			{Host: "blog.thgaltitude.com", Path: "/blog"},
+		},
+		Cache:     []client.CacheDto{{Keys: &client.CacheKeyDto{Header: []string{"Accept"}}}, {}},
+		BasicAuth: &client.BasicAuthDto{Username: "user", Password: "password"},
+		Headers:   []client.HeaderRuleDto{},
+	}
+
+	drift := diffConfig(live, desired)
+
+	if !slices.Equal(drift.routes.added, []string{"/api"}) ||
+		!slices.Equal(drift.routes.removed, []string{"/blog"}) ||
+		!slices.Equal(drift.routes.changed, []string{"/docs"}) {
+		t.Errorf("unexpected route drift %+v", drift.routes)
+	}
+	if !slices.Equal(drift.cache.removed, []string{"1"}) || len(drift.cache.changed) != 0 {
+		t.Errorf("unexpected cache drift %+v", drift.cache)
+	}
+	if !drift.headers.isEmpty() {
+		t.Errorf("expected no header drift, got %+v", drift.headers)
+	}
+	if !slices.Equal(drift.changedSettings, []string{"basic_auth"}) {
+		t.Errorf("unexpected changed settings %v", drift.changedSettings)
+	}
+
+	expected := "- routes added: /api\n- routes removed: /blog\n- routes changed: /docs\n- cache rules removed: 1\n- settings changed: basic_auth"
+	if got := drift.describe(); got != expected {
+		t.Errorf("expected description %q, got %q", expected, got)
+	}
+}
+
+func TestDiffConfigUnorderedLists(t *testing.T) {
+	live := &client.MTEConfigDto{
+		Routes: []client.RouteDto{{Host: "www.thgaltitude.com", Path: "/", Match: &client.RouteMatchDto{Methods: []string{"PUT", "POST"}}}},
+		Cache: []client.CacheDto{{
+			Keys:   &client.CacheKeyDto{Header: []string{"accept-language", "Accept"}, Cookie: []string{"b", "a"}},
+			Bypass: &client.CacheBypassDto{Headers: []string{"authorization"}},
+		}},
+	}
+	desired := &client.MTEConfigDto{
+		Routes: []client.RouteDto{{Host: "www.thgaltitude.com", Path: "/", Match: &client.RouteMatchDto{Methods: []string{"POST", "PUT"}}}},
+		Cache: []client.CacheDto{{
+			Keys:   &client.CacheKeyDto{Header: []string{"Accept", "Accept-Language"}, Cookie: []string{"a", "b"}},
+			Bypass: &client.CacheBypassDto{Headers: []string{"Authorization"}},
+		}},
+	}
+
+	if drift := diffConfig(live, desired); drift.hasDrift() {
+		t.Errorf("expected reordered lists and header name case to be ignored, got %q", drift.describe())
+	}
+
+	desired.Cache[0].Keys.Cookie = []string{"A", "b"}
+	if drift := diffConfig(live, desired); !slices.Equal(drift.cache.changed, []string{"0"}) {
+		t.Errorf("expected cookie names to be compared case-sensitively, got %q", drift.describe())
+	}
+}
@@ -136,7 +136,7 @@ func (d *LoggingEndpointsDataSource) Schema(_ context.Context, _ datasource.Sche
 func (d *LoggingEndpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state LoggingEndpointsDataSourceModel
 
-	loggingEndpoints, err := d.client.ReadMTELoggingEndpoints()
+	loggingEndpoints, err := d.client.ReadMTELoggingEndpoints(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get logging endpoints from Altitude provider",
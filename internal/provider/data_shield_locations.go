@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-altitude/internal/provider/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ShieldLocationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShieldLocationsDataSource{}
+)
+
+// NewShieldLocationsDataSource is a helper function to simplify the provider implementation.
+func NewShieldLocationsDataSource() datasource.DataSource {
+	return &ShieldLocationsDataSource{}
+}
+
+// ShieldLocationsDataSource is the data source implementation.
+type ShieldLocationsDataSource struct {
+	client *client.Client
+}
+
+// ShieldLocationsDataSourceModel maps the data source schema data.
+type ShieldLocationsDataSourceModel struct {
+	Locations []types.String `tfsdk:"locations"`
+	Embedded  types.Bool     `tfsdk:"embedded"`
+}
+
+// readShieldLocations returns the shield locations supported by the Altitude API. If they cannot be
+// read, the list embedded in the provider is returned alongside the error so callers can decide
+// whether to continue with it.
+func readShieldLocations(ctx context.Context, c *client.Client) ([]client.ShieldLocation, error) {
+	shieldLocations, err := c.ReadMTEShieldLocations(ctx)
+	if err != nil {
+		return client.DefaultShieldLocations, err
+	}
+	return shieldLocations.Locations, nil
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ShieldLocationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	resourceData, ok := req.ProviderData.(*ConfiguredData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ConfiguredData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = resourceData.client
+}
+
+// Metadata returns the data source type name.
+func (d *ShieldLocationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mte_shield_locations"
+}
+
+// Schema defines the schema for the data source.
+func (d *ShieldLocationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The shield locations which can be used as the `shield_location` of a route in `altitude_mte_config`.",
+		Attributes: map[string]schema.Attribute{
+			"locations": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The names of the available shield locations, such as `London`.",
+			},
+			"embedded": schema.BoolAttribute{
+				Computed: true,
+				MarkdownDescription: "A boolean which is `true` when the locations could not be read from the Altitude API and the list " +
+					"embedded in the provider was used instead. This list may be missing recently added locations.",
+			},
+		},
+	}
+}
+
+// Read refreshes the terraform state with the latest data.
+func (d *ShieldLocationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ShieldLocationsDataSourceModel
+
+	shieldLocations, err := readShieldLocations(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to get shield locations from Altitude provider",
+			"The shield locations embedded in the provider have been used instead.\n\n"+err.Error(),
+		)
+	}
+
+	state.Embedded = types.BoolValue(err != nil)
+	state.Locations = make([]types.String, len(shieldLocations))
+	for i, l := range shieldLocations {
+		state.Locations[i] = types.StringValue(string(l))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccShieldLocationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "altitude_mte_shield_locations" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.altitude_mte_shield_locations.test", "locations.0"),
+					resource.TestCheckResourceAttr("data.altitude_mte_shield_locations.test", "embedded", "false"),
+				),
+			},
+		},
+	})
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"terraform-provider-altitude/internal/provider/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MTEDomainMappingResource{}
+var _ resource.ResourceWithModifyPlan = &MTEDomainMappingResource{}
 var _ resource.ResourceWithImportState = &MTEDomainMappingResource{}
 
 func NewMTEDomainMappingResource() resource.Resource {
@@ -22,13 +25,17 @@ func NewMTEDomainMappingResource() resource.Resource {
 }
 
 type MTEDomainMappingResource struct {
-	client *client.Client
+	client   *client.Client
+	defaults ProviderDefaults
 }
 
 type MTEDomainMappingResourceModel struct {
-	EnvironmentId types.String `tfsdk:"environment_id"`
-	Domain        types.String `tfsdk:"domain"`
-	DomainMapping types.String `tfsdk:"domain_mapping"`
+	EnvironmentId   types.String   `tfsdk:"environment_id"`
+	Domain          types.String   `tfsdk:"domain"`
+	DomainMapping   types.String   `tfsdk:"domain_mapping"`
+	AdoptExisting   types.Bool     `tfsdk:"adopt_existing"`
+	RenderedPayload types.String   `tfsdk:"rendered_payload"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata implements resource.Resource.
@@ -51,6 +58,26 @@ func (m *MTEDomainMappingResource) Configure(ctx context.Context, req resource.C
 	}
 
 	m.client = resourceData.client
+	m.defaults = resourceData.defaults
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (m *MTEDomainMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	m.defaults.applyEnvironmentId(ctx, req, resp, false)
+
+	if req.Plan.Raw.IsNull() || !planAttributesKnown(resp.Plan, "environment_id", "domain") {
+		return
+	}
+
+	var data MTEDomainMappingResourceModel
+
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rendered_payload"), renderPayload(data.transformToApiRequestBody()))...)
 }
 
 // Schema implements resource.Resource.
@@ -68,19 +95,62 @@ func (m *MTEDomainMappingResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"environment_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The environment which relates with the [config resource](https://registry.terraform.io/providers/THG-Headless/altitude/latest/docs/resources/mte_config).",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The environment which relates with the [config resource](https://registry.terraform.io/providers/THG-Headless/altitude/latest/docs/resources/mte_config). " +
+					"Defaults to the provider's `default_environment_id`.",
 			},
 			"domain_mapping": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The computed value stored as the mapper between domain and config.",
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "A boolean specifying whether an existing mapping for this domain should be taken over on creation " +
+					"instead of failing. The existing mapping will be updated to point at `environment_id`.",
+			},
+			"rendered_payload": renderedPayloadAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 // ImportState implements resource.ResourceWithImportState.
 func (m *MTEDomainMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	validateImportDomain(req.ID, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := m.client.ReadMteDomainMapping(ctx, client.ReadMteDomainMappingInput{Domain: req.ID})
+
+	var notFoundErr *client.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		resp.Diagnostics.AddError(
+			"Cannot Import Non-Existent MTE Domain Mapping",
+			fmt.Sprintf("The domain %q has no domain mapping to import. Check the domain is correct, or remove the import "+
+				"and let Terraform create the mapping instead.", req.ID),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while checking the domain mapping exists:\n\n"+
+				err.Error(),
+		)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
 }
 
@@ -94,12 +164,28 @@ func (m *MTEDomainMappingResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	domainMapping, err := m.client.CreateMteDomainMapping(
+		ctx,
 		client.CreateMteDomainMappingInput{
 			Config: data.transformToApiRequestBody(),
 		},
 	)
 
+	var conflictErr *client.ConflictError
+	if errors.As(err, &conflictErr) && data.AdoptExisting.ValueBool() {
+		domainMapping, err = m.adoptExistingMapping(ctx, &data, resp)
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create MTE domain mapping",
@@ -110,6 +196,7 @@ func (m *MTEDomainMappingResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	data.DomainMapping = types.StringValue(domainMapping)
+	data.RenderedPayload = renderPayload(data.transformToApiRequestBody())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -123,7 +210,18 @@ func (m *MTEDomainMappingResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := m.client.DeleteMteDomainMapping(
+		ctx,
 		client.DeleteMteDomainMappingInput{
 			Domain: data.Domain.ValueString(),
 		},
@@ -146,7 +244,18 @@ func (m *MTEDomainMappingResource) Read(ctx context.Context, req resource.ReadRe
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	domainMapping, err := m.client.ReadMteDomainMapping(
+		ctx,
 		client.ReadMteDomainMappingInput{
 			Domain: data.Domain.ValueString(),
 		},
@@ -158,7 +267,10 @@ func (m *MTEDomainMappingResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	data.DomainMapping = types.StringValue(domainMapping)
+	// The mapping is the environment ID, which is refreshed so that imported mappings have it in state.
+	data.EnvironmentId = types.StringValue(domainMapping)
 
+	data.RenderedPayload = renderPayload(data.transformToApiRequestBody())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -172,7 +284,18 @@ func (m *MTEDomainMappingResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	domainMapping, err := m.client.UpdateMteDomainMapping(
+		ctx,
 		client.UpdateMteDomainMappingInput{
 			Config: plan.transformToApiRequestBody(),
 		},
@@ -188,9 +311,41 @@ func (m *MTEDomainMappingResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	plan.DomainMapping = types.StringValue(domainMapping)
+	plan.RenderedPayload = renderPayload(plan.transformToApiRequestBody())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// adoptExistingMapping takes over a domain mapping which already exists in Altitude by
+// updating it to the planned environment.
+func (m *MTEDomainMappingResource) adoptExistingMapping(ctx context.Context, data *MTEDomainMappingResourceModel, resp *resource.CreateResponse) (string, error) {
+	existingMapping, err := m.client.ReadMteDomainMapping(
+		ctx,
+		client.ReadMteDomainMappingInput{
+			Domain: data.Domain.ValueString(),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	domainMapping, err := m.client.UpdateMteDomainMapping(
+		ctx,
+		client.UpdateMteDomainMappingInput{
+			Config: data.transformToApiRequestBody(),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Adopted existing MTE domain mapping",
+		fmt.Sprintf("The domain %s already had a mapping to %s. This mapping has been taken over by Terraform and now maps to the environment %s.",
+			data.Domain.ValueString(), existingMapping, data.EnvironmentId.ValueString()),
+	)
+	return domainMapping, nil
+}
+
 func (m *MTEDomainMappingResourceModel) transformToApiRequestBody() client.MTEDomainMappingDto {
 	return client.MTEDomainMappingDto{
 		EnvironmentId: m.EnvironmentId.ValueString(),
@@ -30,6 +30,14 @@ func TestAccDomainMappingResource(t *testing.T) {
 					resource.TestCheckResourceAttr("altitude_mte_domain_mapping.tester", "domain_mapping", TEST_ENVIRONMENT_ID),
 				),
 			},
+			{
+				ResourceName:                         "altitude_mte_domain_mapping.tester",
+				ImportState:                          true,
+				ImportStateId:                        TEST_DOMAIN,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"adopt_existing", "timeouts"},
+			},
 		},
 	})
 }
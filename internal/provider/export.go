@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"terraform-provider-altitude/internal/provider/client"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ExportInput lists the live Altitude objects which Export should generate configuration for.
+type ExportInput struct {
+	EnvironmentIds []string
+	Domains        []string
+}
+
+// ExportWarning describes an object which could not be exported. The remaining objects are still
+// included in the generated configuration.
+type ExportWarning struct {
+	Address string
+	Err     error
+}
+
+func (w ExportWarning) Error() string {
+	return fmt.Sprintf("%s: %s", w.Address, w.Err)
+}
+
+// exportIdentifierRegex matches the characters which are not permitted in a resource name.
+var exportIdentifierRegex = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// Export reads the configs of the given environments and the domain and rules mappings of the given
+// domains, and generates the resource and import blocks needed to bring them under management.
+// Environments and domains are sorted, so the output only changes when the live state does.
+func Export(ctx context.Context, c *client.Client, input ExportInput) ([]byte, []ExportWarning) {
+	var warnings []ExportWarning
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	configNames := map[string]string{}
+	configResourceNames := exportNames{}
+	for _, environmentId := range sortedUnique(input.EnvironmentIds) {
+		name := configResourceNames.next(environmentId)
+		address := "altitude_mte_config." + name
+
+		config, err := c.ReadMTEConfig(ctx, client.ReadMTEConfigInput{EnvironmentId: environmentId})
+		if err != nil {
+			warnings = append(warnings, ExportWarning{Address: address, Err: err})
+			continue
+		}
+
+		value, err := exportConfigValue(ctx, environmentId, config)
+		if err != nil {
+			warnings = append(warnings, ExportWarning{Address: address, Err: err})
+			continue
+		}
+
+		block := body.AppendNewBlock("resource", []string{"altitude_mte_config", name}).Body()
+		for _, k := range []string{"environment_id", "config", "tags"} {
+			if v := value.GetAttr(k); !v.IsNull() {
+				block.SetAttributeValue(k, v)
+			}
+		}
+		appendImportBlock(body, "altitude_mte_config", name, environmentId)
+		configNames[environmentId] = name
+	}
+
+	// The domain and rules mapping of a domain share a name, so one set of names serves both types.
+	mappingResourceNames := exportNames{}
+	for _, domain := range sortedUnique(input.Domains) {
+		name := mappingResourceNames.next(domain)
+
+		environmentId, err := c.ReadMteDomainMapping(ctx, client.ReadMteDomainMappingInput{Domain: domain})
+		if err != nil {
+			warnings = append(warnings, ExportWarning{Address: "altitude_mte_domain_mapping." + name, Err: err})
+		} else {
+			block := body.AppendNewBlock("resource", []string{"altitude_mte_domain_mapping", name}).Body()
+			block.SetAttributeValue("domain", cty.StringVal(domain))
+			if configName, ok := configNames[environmentId]; ok {
+				block.SetAttributeTraversal("environment_id", hcl.Traversal{
+					hcl.TraverseRoot{Name: "altitude_mte_config"},
+					hcl.TraverseAttr{Name: configName},
+					hcl.TraverseAttr{Name: "environment_id"},
+				})
+			} else {
+				block.SetAttributeValue("environment_id", cty.StringVal(environmentId))
+			}
+			appendImportBlock(body, "altitude_mte_domain_mapping", name, domain)
+		}
+
+		rulesId, err := c.ReadMteRulesMapping(ctx, client.ReadMteRulesMappingInput{Domain: domain})
+		if err != nil {
+			warnings = append(warnings, ExportWarning{Address: "altitude_mte_rules_mapping." + name, Err: err})
+		} else {
+			block := body.AppendNewBlock("resource", []string{"altitude_mte_rules_mapping", name}).Body()
+			block.SetAttributeValue("domain", cty.StringVal(domain))
+			block.SetAttributeValue("rules_id", cty.StringVal(rulesId))
+			appendImportBlock(body, "altitude_mte_rules_mapping", name, domain)
+		}
+	}
+
+	return hclwrite.Format(file.Bytes()), warnings
+}
+
+func appendImportBlock(body *hclwrite.Body, resourceType string, name string, id string) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	block.SetAttributeValue("id", cty.StringVal(id))
+	body.AppendNewline()
+}
+
+// exportConfigValue converts a config read from the API into the value of an altitude_mte_config
+// resource, using the same transform and schema as the resource itself.
+func exportConfigValue(ctx context.Context, environmentId string, dto *client.MTEConfigDto) (cty.Value, error) {
+	var schemaResp resource.SchemaResponse
+	(&MTEConfigResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &MTEConfigResourceModel{
+		EnvironmentId: types.StringValue(environmentId),
+		Config:        transformToResourceModel(dto),
+		Tags:          tagsToMap(dto.Tags),
+		TagsAll:       types.MapNull(types.StringType),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(schemaResp.Schema.Blocks["timeouts"].Type().(timeouts.Type).AttrTypes),
+		},
+	})
+	if diags.HasError() {
+		return cty.NilVal, fmt.Errorf("unable to convert config: %v", diags)
+	}
+
+	return exportValue(state.Raw)
+}
+
+// exportValue converts a Terraform value into the equivalent HCL literal. Null attributes are
+// omitted, so only the values which are set appear in the generated configuration.
+func exportValue(v tftypes.Value) (cty.Value, error) {
+	if v.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return cty.StringVal(s), err
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return cty.BoolVal(b), err
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		err := v.As(&n)
+		return cty.NumberVal(n), err
+	case v.Type().Is(tftypes.List{}) || v.Type().Is(tftypes.Set{}) || v.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		values := make([]cty.Value, len(elements))
+		for i, e := range elements {
+			value, err := exportValue(e)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[i] = value
+		}
+		return cty.TupleVal(values), nil
+	case v.Type().Is(tftypes.Map{}) || v.Type().Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := v.As(&attributes); err != nil {
+			return cty.NilVal, err
+		}
+		values := make(map[string]cty.Value, len(attributes))
+		for k, a := range attributes {
+			if a.IsNull() {
+				continue
+			}
+			value, err := exportValue(a)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[k] = value
+		}
+		return cty.ObjectVal(values), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("unsupported value type %s", v.Type())
+}
+
+// exportResourceName turns an environment ID or domain into a valid resource name.
+func exportResourceName(id string) string {
+	name := exportIdentifierRegex.ReplaceAllString(id, "_")
+	if name == "" || !(name[0] >= 'A' && name[0] <= 'Z' || name[0] >= 'a' && name[0] <= 'z' || name[0] == '_') {
+		name = "_" + name
+	}
+	return name
+}
+
+// exportNames tracks the resource names used for one resource type, as different IDs such as
+// `www.a.com` and `www_a.com` can map to the same name.
+type exportNames map[string]bool
+
+// next returns the resource name for id, adding a numeric suffix when the name is already used. IDs
+// are exported in sorted order, so the suffixes are the same on every run.
+func (n exportNames) next(id string) string {
+	base := exportResourceName(id)
+	name := base
+	for i := 2; n[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	n[name] = true
+	return name
+}
+
+func sortedUnique(values []string) []string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"terraform-provider-altitude/internal/provider/client"
+)
+
+func TestExportConfigValue(t *testing.T) {
+	retries := int64(2)
+	dto := &client.MTEConfigDto{
+		Routes: []client.RouteDto{
+			{
+				Host:               "www.thgaltitude.com",
+				Path:               "/",
+				EnableSsl:          true,
+				PreservePathPrefix: true,
+				RetryAttempts:      &retries,
+			},
+		},
+		Headers: []client.HeaderRuleDto{
+			{
+				Response: &client.HeaderActionsDto{
+					Set: map[string]string{"X-Frame-Options": "DENY"},
+				},
+			},
+		},
+		Tags: map[string]string{"team": "platform"},
+	}
+
+	value, err := exportConfigValue(context.Background(), "production", dto)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := hclwrite.NewEmptyFile()
+	for _, k := range []string{"environment_id", "config", "tags"} {
+		file.Body().SetAttributeValue(k, value.GetAttr(k))
+	}
+
+	expected := `environment_id = "production"
+config = {
+  headers = [{
+    response = {
+      set = {
+        X-Frame-Options = "DENY"
+      }
+    }
+  }]
+  routes = [{
+    enable_ssl           = true
+    host                 = "www.thgaltitude.com"
+    path                 = "/"
+    preserve_path_prefix = true
+    retry_attempts       = 2
+  }]
+}
+tags = {
+  team = "platform"
+}
+`
+	if got := string(hclwrite.Format(file.Bytes())); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestExportResourceName(t *testing.T) {
+	testCases := map[string]string{
+		"production":          "production",
+		"www.thgaltitude.com": "www_thgaltitude_com",
+		"123-abc":             "_123-abc",
+	}
+
+	for id, expected := range testCases {
+		if got := exportResourceName(id); got != expected {
+			t.Errorf("expected %q for %q, got %q", expected, id, got)
+		}
+	}
+}
+
+func TestExportNames(t *testing.T) {
+	names := exportNames{}
+	testCases := []struct {
+		id       string
+		expected string
+	}{
+		{id: "www.a.com", expected: "www_a_com"},
+		{id: "www_a.com", expected: "www_a_com_2"},
+		{id: "www_a_com_2", expected: "www_a_com_2_2"},
+		{id: "www.b.com", expected: "www_b_com"},
+	}
+
+	for _, tc := range testCases {
+		if got := names.next(tc.id); got != tc.expected {
+			t.Errorf("expected %q for %q, got %q", tc.expected, tc.id, got)
+		}
+	}
+}
+
+func TestExportCollidingNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("production"))
+	}))
+	defer server.Close()
+
+	c, err := client.NewWithAuthenticator(client.AccessToken{Token: "test"}, client.Local, client.WithEndpoints(client.Endpoints{BaseUrl: server.URL}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, warnings := Export(context.Background(), c, ExportInput{Domains: []string{"www_a.com", "www.a.com"}})
+	if len(warnings) != 0 {
+		t.Fatalf("unexpected warnings: %v", warnings)
+	}
+
+	for _, expected := range []string{
+		`resource "altitude_mte_domain_mapping" "www_a_com" {`,
+		`resource "altitude_mte_domain_mapping" "www_a_com_2" {`,
+		`resource "altitude_mte_rules_mapping" "www_a_com" {`,
+		`resource "altitude_mte_rules_mapping" "www_a_com_2" {`,
+		"to = altitude_mte_domain_mapping.www_a_com_2",
+	} {
+		if strings.Count(string(output), expected) != 1 {
+			t.Errorf("expected %q exactly once in:\n%s", expected, output)
+		}
+	}
+}
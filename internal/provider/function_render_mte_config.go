@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &RenderMTEConfigFunction{}
+
+// NewRenderMTEConfigFunction is a helper function to simplify the provider implementation.
+func NewRenderMTEConfigFunction() function.Function {
+	return &RenderMTEConfigFunction{}
+}
+
+// RenderMTEConfigFunction renders a config as the JSON document sent to the Altitude API.
+type RenderMTEConfigFunction struct{}
+
+// Metadata implements function.Function.
+func (f *RenderMTEConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_mte_config"
+}
+
+// Definition implements function.Function.
+func (f *RenderMTEConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render an MTE config as the payload sent to the Altitude API",
+		MarkdownDescription: "Renders a config as the JSON document which is sent to the Altitude API, with any secrets redacted. " +
+			"This is the same document as the `rendered_payload` attribute of the `altitude_mte_config` resource, " +
+			"excluding tags as these depend on the provider's `default_tags`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "config",
+				MarkdownDescription: "The config as a JSON encoded string, in the same shape as the `config` attribute of the " +
+					"`altitude_mte_config` resource. Typically produced with `jsonencode`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run implements function.Function.
+func (f *RenderMTEConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &config))
+
+	if resp.Error != nil {
+		return
+	}
+
+	model, diags := decodeConfigJSON(ctx, []byte(config))
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, diags.Errors()[0].Detail()))
+		return
+	}
+
+	rendered := (&MTEConfigResourceModel{Config: *model}).renderPayload()
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rendered))
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRenderMTEConfigFunction(t *testing.T) {
+	testCases := map[string]struct {
+		config   string
+		expected string
+		isError  bool
+	}{
+		"route": {
+			config:   `{"routes":[{"host":"www.example.com","path":"/","enable_ssl":true,"preserve_path_prefix":true}]}`,
+			expected: `{"routes":[{"host":"www.example.com","path":"/","enableSsl":true,"preservePathPrefix":true}]}`,
+		},
+		"redacts-basic-auth": {
+			config:   `{"routes":[],"basic_auth":{"username":"user","password":"hunter2"}}`,
+			expected: `{"routes":[],"basicAuth":{"username":"user","password":"(sensitive)"}}`,
+		},
+		"invalid-json": {
+			config:  `{"routes":`,
+			isError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.config)}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&RenderMTEConfigFunction{}).Run(context.Background(), req, &resp)
+
+			if tc.isError {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// importIdPartRegex matches a single part of an import ID, such as an environment ID.
+var importIdPartRegex = regexp.MustCompile(`^[^\s/]+$`)
+
+// domainRegex matches a hostname without a scheme, port or path, which may be a wildcard such as *.example.com.
+var domainRegex = regexp.MustCompile(`^(\*\.)?([A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?\.)*[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?$`)
+
+// splitImportId splits a composite import ID into n parts separated by slashes. format describes
+// the expected ID to the user, for example "<source_environment_id>/<target_environment_id>".
+func splitImportId(id string, n int, format string, diags *diag.Diagnostics) []string {
+	parts := strings.Split(id, "/")
+	if len(parts) != n {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the format %q, got %q.", format, id),
+		)
+		return nil
+	}
+	for i, p := range parts {
+		if !importIdPartRegex.MatchString(p) {
+			diags.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected an import ID in the format %q, got %q. Part %d must not be empty or contain whitespace.", format, id, i+1),
+			)
+			return nil
+		}
+	}
+	return parts
+}
+
+// validateImportEnvironmentId checks that an import ID is a single environment ID.
+func validateImportEnvironmentId(id string, diags *diag.Diagnostics) {
+	if !importIdPartRegex.MatchString(id) {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the import ID to be an environment ID, got %q. Environment IDs do not contain whitespace or slashes.", id),
+		)
+	}
+}
+
+// validateImportDomain checks that an import ID is a domain, pointing out common mistakes such as including the scheme.
+func validateImportDomain(id string, diags *diag.Diagnostics) {
+	detail := ""
+	switch {
+	case strings.Contains(id, "://"):
+		detail = " Remove the scheme, such as https://, from the domain."
+	case strings.Contains(id, "/"):
+		detail = " Remove the path from the domain."
+	case strings.Contains(id, ":"):
+		detail = " Remove the port from the domain."
+	case !domainRegex.MatchString(id):
+		detail = " Domains may only contain letters, numbers, hyphens and dots, and may start with a *. wildcard."
+	default:
+		return
+	}
+	diags.AddError(
+		"Invalid Import ID",
+		fmt.Sprintf("Expected the import ID to be a domain, such as www.example.com, got %q.%s", id, detail),
+	)
+}
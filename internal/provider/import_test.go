@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestSplitImportId(t *testing.T) {
+	testCases := map[string]struct {
+		id       string
+		expected []string
+	}{
+		"valid":        {id: "source/target", expected: []string{"source", "target"}},
+		"missing-part": {id: "source"},
+		"extra-part":   {id: "source/target/other"},
+		"empty-part":   {id: "source/"},
+		"whitespace":   {id: "source/tar get"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			parts := splitImportId(tc.id, 2, "<source>/<target>", &diags)
+
+			if tc.expected == nil {
+				if !diags.HasError() {
+					t.Fatalf("expected an error for %q, got parts %v", tc.id, parts)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(parts) != len(tc.expected) || parts[0] != tc.expected[0] || parts[1] != tc.expected[1] {
+				t.Errorf("expected %v, got %v", tc.expected, parts)
+			}
+		})
+	}
+}
+
+func TestValidateImportEnvironmentId(t *testing.T) {
+	testCases := map[string]struct {
+		id      string
+		isValid bool
+	}{
+		"valid":      {id: "prod-environment", isValid: true},
+		"empty":      {id: ""},
+		"slash":      {id: "prod/environment"},
+		"whitespace": {id: "prod environment"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateImportEnvironmentId(tc.id, &diags)
+
+			if diags.HasError() == tc.isValid {
+				t.Errorf("expected valid=%v for %q, got diagnostics %v", tc.isValid, tc.id, diags)
+			}
+		})
+	}
+}
+
+func TestValidateImportDomain(t *testing.T) {
+	testCases := map[string]struct {
+		id      string
+		isValid bool
+	}{
+		"valid":        {id: "www.example.com", isValid: true},
+		"single":       {id: "localhost", isValid: true},
+		"wildcard":     {id: "*.example.com", isValid: true},
+		"mid-wildcard": {id: "www.*.example.com"},
+		"scheme":       {id: "https://www.example.com"},
+		"path":         {id: "www.example.com/path"},
+		"port":         {id: "www.example.com:443"},
+		"underscore":   {id: "www_example.com"},
+		"empty":        {id: ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateImportDomain(tc.id, &diags)
+
+			if diags.HasError() == tc.isValid {
+				t.Errorf("expected valid=%v for %q, got diagnostics %v", tc.isValid, tc.id, diags)
+			}
+		})
+	}
+}
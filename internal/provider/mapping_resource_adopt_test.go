@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"terraform-provider-altitude/internal/provider/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newConflictingMappingClient returns a client for a fake Altitude API in which the mapping already exists,
+// so that creation fails with a 409, along with the method and path of every request it receives.
+func newConflictingMappingClient(t *testing.T, existing string) (*client.Client, func() []string) {
+	var mu sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusConflict)
+		case http.MethodGet:
+			_, _ = w.Write([]byte(existing))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewWithAuthenticator(client.AccessToken{Token: "test"}, client.Local, client.WithEndpoints(client.Endpoints{BaseUrl: server.URL}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(requests)
+	}
+}
+
+// createWithPlan calls Create on r with a plan of the given attribute values, leaving the rest null.
+func createWithPlan(t *testing.T, r resource.Resource, values map[string]tftypes.Value) resource.CreateResponse {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	planValues := make(map[string]tftypes.Value)
+	for attr, attrType := range objectType.AttributeTypes {
+		planValues[attr] = tftypes.NewValue(attrType, nil)
+	}
+	for attr, value := range values {
+		planValues[attr] = value
+	}
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Raw: tftypes.NewValue(objectType, planValues), Schema: schemaResp.Schema},
+	}
+	resp := resource.CreateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(objectType, nil), Schema: schemaResp.Schema},
+	}
+	r.Create(ctx, req, &resp)
+	return resp
+}
+
+func TestMTEDomainMappingResourceAdoptExisting(t *testing.T) {
+	testCases := map[string]struct {
+		adoptExisting    bool
+		expectedRequests []string
+		isError          bool
+	}{
+		"adopt": {
+			adoptExisting:    true,
+			expectedRequests: []string{"POST /v1/mte/domain-mapping", "GET /v1/mte/domain-mapping", "PUT /v1/mte/domain-mapping"},
+		},
+		"conflict": {
+			adoptExisting:    false,
+			expectedRequests: []string{"POST /v1/mte/domain-mapping"},
+			isError:          true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, requests := newConflictingMappingClient(t, "old-environment")
+			r := &MTEDomainMappingResource{client: c}
+
+			resp := createWithPlan(t, r, map[string]tftypes.Value{
+				"environment_id": tftypes.NewValue(tftypes.String, "new-environment"),
+				"domain":         tftypes.NewValue(tftypes.String, "www.thgaltitude.com"),
+				"adopt_existing": tftypes.NewValue(tftypes.Bool, tc.adoptExisting),
+			})
+
+			if resp.Diagnostics.HasError() != tc.isError {
+				t.Fatalf("expected error=%v, got diagnostics %v", tc.isError, resp.Diagnostics)
+			}
+			if got := requests(); !slices.Equal(got, tc.expectedRequests) {
+				t.Errorf("expected requests %v, got %v", tc.expectedRequests, got)
+			}
+			if tc.isError {
+				if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "This domain already has an associated mapping.") {
+					t.Errorf("expected the conflict to be reported, got %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "old-environment") {
+				t.Errorf("expected a warning naming the adopted mapping, got %v", resp.Diagnostics)
+			}
+			var state MTEDomainMappingResourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.EnvironmentId != types.StringValue("new-environment") || state.DomainMapping.IsNull() {
+				t.Errorf("expected the adopted mapping to be recorded in state, got %+v", state)
+			}
+		})
+	}
+}
+
+func TestMTERulesMappingResourceAdoptExisting(t *testing.T) {
+	testCases := map[string]struct {
+		adoptExisting    bool
+		expectedRequests []string
+		isError          bool
+	}{
+		"adopt": {
+			adoptExisting:    true,
+			expectedRequests: []string{"POST /v1/mte/rules-mapping", "GET /v1/mte/rules-mapping", "PUT /v1/mte/rules-mapping"},
+		},
+		"conflict": {
+			adoptExisting:    false,
+			expectedRequests: []string{"POST /v1/mte/rules-mapping"},
+			isError:          true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, requests := newConflictingMappingClient(t, "old-rules")
+			r := &MTERulesMappingResource{client: c}
+
+			resp := createWithPlan(t, r, map[string]tftypes.Value{
+				"rules_id":       tftypes.NewValue(tftypes.String, "new-rules"),
+				"domain":         tftypes.NewValue(tftypes.String, "www.thgaltitude.com"),
+				"adopt_existing": tftypes.NewValue(tftypes.Bool, tc.adoptExisting),
+			})
+
+			if resp.Diagnostics.HasError() != tc.isError {
+				t.Fatalf("expected error=%v, got diagnostics %v", tc.isError, resp.Diagnostics)
+			}
+			if got := requests(); !slices.Equal(got, tc.expectedRequests) {
+				t.Errorf("expected requests %v, got %v", tc.expectedRequests, got)
+			}
+			if tc.isError {
+				if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "This environment already has an associated config block.") {
+					t.Errorf("expected the conflict to be reported, got %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "old-rules") {
+				t.Errorf("expected a warning naming the adopted mapping, got %v", resp.Diagnostics)
+			}
+			var state MTERulesMappingResourceModel
+			resp.State.Get(context.Background(), &state)
+			if state.RulesId != types.StringValue("new-rules") {
+				t.Errorf("expected the adopted mapping to be recorded in state, got %+v", state)
+			}
+		})
+	}
+}
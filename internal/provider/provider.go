@@ -2,9 +2,10 @@ package provider
 
 import (
 	"context"
-	"os"
+	"fmt"
 	"terraform-provider-altitude/internal/provider/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -24,14 +25,24 @@ type altitudeProvider struct {
 }
 
 type ConfiguredData struct {
-	client *client.Client
+	client   *client.Client
+	defaults ProviderDefaults
 }
 
 // ProviderModel describes the provider data model.
 type ProviderModel struct {
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	Mode         types.String `tfsdk:"mode"`
+	ClientId               types.String            `tfsdk:"client_id"`
+	ClientSecret           types.String            `tfsdk:"client_secret"`
+	AccessToken            types.String            `tfsdk:"access_token"`
+	AccessTokenFile        types.String            `tfsdk:"access_token_file"`
+	IdentityTokenFile      types.String            `tfsdk:"identity_token_file"`
+	IdentityTokenGrantType types.String            `tfsdk:"identity_token_grant_type"`
+	Mode                   types.String            `tfsdk:"mode"`
+	Profile                types.String            `tfsdk:"profile"`
+	DefaultEnvironmentId   types.String            `tfsdk:"default_environment_id"`
+	DefaultTags            map[string]types.String `tfsdk:"default_tags"`
+	MaxConcurrentRequests  types.Int64             `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond      types.Int64             `tfsdk:"requests_per_second"`
 }
 
 func (p *altitudeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,6 +52,13 @@ func (p *altitudeProvider) Metadata(ctx context.Context, req provider.MetadataRe
 
 func (p *altitudeProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "The provider authenticates with the first of the following which is configured, warning if any others are also configured: " +
+			"`access_token`, `access_token_file`, `identity_token_file` to exchange a workload identity token such as a CI OIDC token, " +
+			"then `client_id` and `client_secret`. Each can be set with its attribute or the matching `ALTITUDE_*` environment variable, " +
+			"with the attribute taking precedence.\n\n" +
+			"Settings which are set by neither are read from the profile selected with `profile` in the shared credentials file " +
+			"`~/.altitude/credentials`, or from its `default` profile. The file can be moved with the `ALTITUDE_CREDENTIALS_FILE` environment variable. " +
+			"It is an INI file of named profiles, each of which may set `client_id`, `client_secret`, `mode`, and the custom endpoints `base_url`, `audience` and `issuer`.",
 		Attributes: map[string]schema.Attribute{
 			"client_id": schema.StringAttribute{
 				Description: "The unique identifier for the OAuth Application.",
@@ -52,6 +70,31 @@ func (p *altitudeProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "An access token for the Altitude API obtained outside of the provider. It cannot be refreshed, so must outlive the run. " +
+					"Can also be set with the `ALTITUDE_ACCESS_TOKEN` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"access_token_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a file containing an access token for the Altitude API. The file is read again when the token nears " +
+					"expiry or is rejected, so it can be rotated during a run. Can also be set with the `ALTITUDE_ACCESS_TOKEN_FILE` environment variable.",
+				Optional: true,
+			},
+			"identity_token_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a file containing an identity token issued to the workload, such as the OIDC token of a CI job, " +
+					"which is exchanged for an access token. `client_id` is sent with the exchange when set. " +
+					"Can also be set with the `ALTITUDE_IDENTITY_TOKEN_FILE` environment variable.",
+				Optional: true,
+			},
+			"identity_token_grant_type": schema.StringAttribute{
+				MarkdownDescription: "The OAuth grant used to exchange `identity_token_file`, either `jwt-bearer` or `token-exchange`. Defaults to `jwt-bearer`. " +
+					"Can also be set with the `ALTITUDE_IDENTITY_TOKEN_GRANT_TYPE` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("jwt-bearer", "token-exchange"),
+				},
+			},
 			"mode": schema.StringAttribute{
 				MarkdownDescription: "The environment selected for development which in turn sets the base URL for Altitude API. This value can be either `Production`, `UAT` or `Local`. It defaults to Local.",
 				Optional:            true,
@@ -63,6 +106,36 @@ func (p *altitudeProvider) Schema(ctx context.Context, req provider.SchemaReques
 					),
 				},
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The name of the profile in the shared credentials file to read settings from. " +
+					"Can also be set with the `ALTITUDE_PROFILE` environment variable. Defaults to `default` if the file has such a profile.",
+				Optional: true,
+			},
+			"default_environment_id": schema.StringAttribute{
+				MarkdownDescription: "The environment ID used by resources which do not set `environment_id` themselves.",
+				Optional:            true,
+			},
+			"default_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "Tags, such as the owning team or cost centre, added to every resource which supports them. Only `altitude_mte_config` supports tags. " +
+					"Tags set on a resource take precedence over these. The merged result is shown in each resource's `tags_all` attribute.",
+				Optional: true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests to the Altitude API which may be in flight at once. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: "The maximum rate of requests to the Altitude API. Short bursts of up to this many requests are sent at once " +
+					"after a quiet period. Unlimited by default.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -104,57 +177,97 @@ func (p *altitudeProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.DefaultEnvironmentId.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_environment_id"),
+			"Unknown Default Environment ID",
+			"The provider cannot apply the default environment ID as its value is unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	for _, a := range []struct {
+		name  string
+		value types.String
+	}{
+		{"access_token", config.AccessToken},
+		{"access_token_file", config.AccessTokenFile},
+		{"identity_token_file", config.IdentityTokenFile},
+		{"identity_token_grant_type", config.IdentityTokenGrantType},
+		{"profile", config.Profile},
+	} {
+		if a.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(a.name),
+				"Unknown Altitude API Credentials",
+				fmt.Sprintf("The provider cannot create the Altitude API client as there is an unknown configuration value for %s. ", a.name)+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+	}
+
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown Maximum Concurrent Requests",
+			"The provider cannot create the Altitude API client as there is an unknown configuration value for the maximum concurrent requests. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Requests Per Second",
+			"The provider cannot create the Altitude API client as there is an unknown configuration value for the requests per second. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	clientId := os.Getenv("ALTITUDE_CLIENT_ID")
-	clientSecret := os.Getenv("ALTITUDE_CLIENT_SECRET")
-	mode := client.Mode(os.Getenv("ALTITUDE_MODE"))
+	profile, diags := loadProfile(configOrEnv(config.Profile, "ALTITUDE_PROFILE", ""))
+	resp.Diagnostics.Append(diags...)
 
-	if !config.Mode.IsNull() {
-		mode = client.Mode(config.Mode.ValueString())
-	} else if !mode.IsValid() {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode := client.Mode(configOrEnv(config.Mode, "ALTITUDE_MODE", profile.mode))
+
+	if !mode.IsValid() {
 		resp.Diagnostics.AddAttributeWarning(
 			path.Root("mode"),
 			"Unknown Altitude API Mode",
-			"The environment variable ALTITUDE_MODE was either unset or was not on of the permitted values. Defaulting to using the mode `Local`.",
+			"The mode was either unset in the configuration, the ALTITUDE_MODE environment variable and the selected profile, "+
+				"or was not one of the permitted values. Defaulting to using the mode `Local`.",
 		)
 		mode = client.Local
 	}
 
-	if !config.ClientId.IsNull() {
-		clientId = config.ClientId.ValueString()
-	}
+	authenticator := authSettingsFromConfig(config, profile).authenticator(&resp.Diagnostics)
 
-	if !config.ClientSecret.IsNull() {
-		clientSecret = config.ClientSecret.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if clientId == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("client_id"),
-			"Missing Altitude Client ID",
-			"The provider cannot create the Altitude API client as there is a missing or empty value for the Altitude Client ID. "+
-				"Set the client_id value in the configuration or use the ALTITUDE_CLIENT_ID environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	var opts []client.Option
+	if profile.endpoints != (client.Endpoints{}) {
+		opts = append(opts, client.WithEndpoints(profile.endpoints))
 	}
-
-	if clientSecret == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Missing Altitude Client Secret",
-			"The provider cannot create the Altitude API client as there is a missing or empty value for the Altitude Client Secret. "+
-				"Set the client_secret value in the configuration or use the ALTITUDE_CLIENT_SECRET environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	if !config.MaxConcurrentRequests.IsNull() {
+		opts = append(opts, client.WithMaxConcurrentRequests(config.MaxConcurrentRequests.ValueInt64()))
+	}
+	if !config.RequestsPerSecond.IsNull() {
+		opts = append(opts, client.WithRateLimit(config.RequestsPerSecond.ValueInt64()))
 	}
 
-	client, err := client.New(
-		clientId,
-		clientSecret,
+	client, err := client.NewWithAuthenticator(
+		authenticator,
 		mode,
+		opts...,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -165,6 +278,15 @@ func (p *altitudeProvider) Configure(ctx context.Context, req provider.Configure
 	}
 	var downstreamData = ConfiguredData{
 		client: client,
+		defaults: ProviderDefaults{
+			EnvironmentId: config.DefaultEnvironmentId.ValueString(),
+		},
+	}
+	if config.DefaultTags != nil {
+		downstreamData.defaults.Tags = make(map[string]string, len(config.DefaultTags))
+		for k, v := range config.DefaultTags {
+			downstreamData.defaults.Tags[k] = v.ValueString()
+		}
 	}
 	resp.DataSourceData = &downstreamData
 	resp.ResourceData = &downstreamData
@@ -175,17 +297,23 @@ func (p *altitudeProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewMTEConfigResource,
 		NewMTEDomainMappingResource,
 		NewMTERulesMappingResource,
+		NewMTECachePurgeResource,
+		NewMTEConfigPromotionResource,
 	}
 }
 
 func (p *altitudeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewLoggingEndpointsDataSource,
+		NewShieldLocationsDataSource,
+		NewConfigDriftDataSource,
 	}
 }
 
 func (p *altitudeProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewRenderMTEConfigFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
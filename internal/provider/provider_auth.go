@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"terraform-provider-altitude/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityTokenGrants maps the values of `identity_token_grant_type` to their OAuth grants.
+var identityTokenGrants = map[string]client.IdentityTokenGrant{
+	"jwt-bearer":     client.JWTBearerGrant,
+	"token-exchange": client.TokenExchangeGrant,
+}
+
+// authSettings are the authentication settings resolved from the provider configuration and environment.
+type authSettings struct {
+	clientId               string
+	clientSecret           string
+	accessToken            string
+	accessTokenFile        string
+	identityTokenFile      string
+	identityTokenGrantType string
+}
+
+// configOrEnv returns the configured value, falling back to the environment variable and then to fallback,
+// such as the value from a profile, when each is unset.
+func configOrEnv(value types.String, env string, fallback string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	if v := os.Getenv(env); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func authSettingsFromConfig(config ProviderModel, profile credentialsProfile) authSettings {
+	return authSettings{
+		clientId:               configOrEnv(config.ClientId, "ALTITUDE_CLIENT_ID", profile.clientId),
+		clientSecret:           configOrEnv(config.ClientSecret, "ALTITUDE_CLIENT_SECRET", profile.clientSecret),
+		accessToken:            configOrEnv(config.AccessToken, "ALTITUDE_ACCESS_TOKEN", ""),
+		accessTokenFile:        configOrEnv(config.AccessTokenFile, "ALTITUDE_ACCESS_TOKEN_FILE", ""),
+		identityTokenFile:      configOrEnv(config.IdentityTokenFile, "ALTITUDE_IDENTITY_TOKEN_FILE", ""),
+		identityTokenGrantType: configOrEnv(config.IdentityTokenGrantType, "ALTITUDE_IDENTITY_TOKEN_GRANT_TYPE", ""),
+	}
+}
+
+// authenticator picks how to authenticate with the Altitude API. Methods take precedence in the order
+// access_token, access_token_file, identity_token_file, then client_id and client_secret. When more than
+// one is configured the first is used and a warning lists those which were ignored.
+func (s authSettings) authenticator(diags *diag.Diagnostics) client.Authenticator {
+	var methods []string
+	var authenticator client.Authenticator
+	use := func(method string, a client.Authenticator) {
+		methods = append(methods, method)
+		if authenticator == nil {
+			authenticator = a
+		}
+	}
+
+	if s.accessToken != "" {
+		use("access_token", client.AccessToken{Token: s.accessToken})
+	}
+	if s.accessTokenFile != "" {
+		use("access_token_file", client.AccessTokenFile{Path: s.accessTokenFile})
+	}
+	if s.identityTokenFile != "" {
+		grantType := s.identityTokenGrantType
+		if grantType == "" {
+			grantType = "jwt-bearer"
+		}
+		grant, ok := identityTokenGrants[grantType]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("identity_token_grant_type"),
+				"Invalid Identity Token Grant Type",
+				fmt.Sprintf("The identity token grant type %q is not supported. It must be either `jwt-bearer` or `token-exchange`.", grantType),
+			)
+			return nil
+		}
+		use("identity_token_file", client.IdentityToken{Path: s.identityTokenFile, ClientId: s.clientId, Grant: grant})
+	}
+	// client_id alone is also used by identity_token_file, so only counts as client credentials with a secret.
+	if s.clientSecret != "" || (authenticator == nil && s.clientId != "") {
+		use("client_id and client_secret", client.ClientCredentials{ClientId: s.clientId, ClientSecret: s.clientSecret})
+	}
+
+	if len(methods) > 1 {
+		diags.AddWarning(
+			"Multiple Altitude Authentication Methods Configured",
+			fmt.Sprintf("Authenticating using %s. The following are also configured but are ignored: %s. "+
+				"Authentication methods take precedence in the order access_token, access_token_file, identity_token_file, then client_id and client_secret.",
+				methods[0], strings.Join(methods[1:], ", ")),
+		)
+	}
+
+	if _, ok := authenticator.(client.ClientCredentials); authenticator != nil && !ok {
+		return authenticator
+	}
+
+	if s.clientId == "" {
+		diags.AddAttributeError(
+			path.Root("client_id"),
+			"Missing Altitude Client ID",
+			"The provider cannot create the Altitude API client as there is a missing or empty value for the Altitude Client ID. "+
+				"Set the client_id value in the configuration, the ALTITUDE_CLIENT_ID environment variable or the selected profile, "+
+				"or authenticate with access_token, access_token_file or identity_token_file instead. "+
+				"If any is already set, ensure the value is not empty.",
+		)
+	}
+
+	if s.clientSecret == "" {
+		diags.AddAttributeError(
+			path.Root("client_secret"),
+			"Missing Altitude Client Secret",
+			"The provider cannot create the Altitude API client as there is a missing or empty value for the Altitude Client Secret. "+
+				"Set the client_secret value in the configuration, the ALTITUDE_CLIENT_SECRET environment variable or the selected profile, "+
+				"or authenticate with access_token, access_token_file or identity_token_file instead. "+
+				"If any is already set, ensure the value is not empty.",
+		)
+	}
+
+	return authenticator
+}
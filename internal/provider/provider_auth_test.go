@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"reflect"
+	"terraform-provider-altitude/internal/provider/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAuthSettingsAuthenticator(t *testing.T) {
+	testCases := map[string]struct {
+		settings  authSettings
+		expected  client.Authenticator
+		isWarning bool
+		isError   bool
+	}{
+		"client-credentials": {
+			settings: authSettings{clientId: "id", clientSecret: "secret"},
+			expected: client.ClientCredentials{ClientId: "id", ClientSecret: "secret"},
+		},
+		"access-token": {
+			settings: authSettings{accessToken: "token"},
+			expected: client.AccessToken{Token: "token"},
+		},
+		"access-token-file": {
+			settings: authSettings{accessTokenFile: "/run/token"},
+			expected: client.AccessTokenFile{Path: "/run/token"},
+		},
+		"identity-token-default-grant": {
+			settings: authSettings{identityTokenFile: "/run/oidc", clientId: "id"},
+			expected: client.IdentityToken{Path: "/run/oidc", ClientId: "id", Grant: client.JWTBearerGrant},
+		},
+		"identity-token-exchange": {
+			settings: authSettings{identityTokenFile: "/run/oidc", identityTokenGrantType: "token-exchange"},
+			expected: client.IdentityToken{Path: "/run/oidc", Grant: client.TokenExchangeGrant},
+		},
+		"identity-token-invalid-grant": {
+			settings: authSettings{identityTokenFile: "/run/oidc", identityTokenGrantType: "password"},
+			isError:  true,
+		},
+		"precedence": {
+			settings:  authSettings{accessToken: "token", accessTokenFile: "/run/token", clientId: "id", clientSecret: "secret"},
+			expected:  client.AccessToken{Token: "token"},
+			isWarning: true,
+		},
+		"missing-secret": {
+			settings: authSettings{clientId: "id"},
+			isError:  true,
+		},
+		"none": {
+			isError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			authenticator := tc.settings.authenticator(&diags)
+
+			if diags.HasError() != tc.isError {
+				t.Fatalf("expected error=%v, got diagnostics %v", tc.isError, diags)
+			}
+			if (diags.WarningsCount() > 0) != tc.isWarning {
+				t.Errorf("expected warning=%v, got diagnostics %v", tc.isWarning, diags)
+			}
+			if !tc.isError && !reflect.DeepEqual(authenticator, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, authenticator)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProviderDefaults holds values from the provider configuration which are applied to resources
+// that do not set them themselves.
+type ProviderDefaults struct {
+	EnvironmentId string
+	Tags          map[string]string
+}
+
+// applyEnvironmentId sets environment_id in the plan to default_environment_id when the resource
+// configuration omits it. When requiresReplace is set and the default differs from the current
+// state, the resource is marked for replacement, as the attribute's own plan modifiers only see
+// the configured value.
+func (d ProviderDefaults) applyEnvironmentId(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, requiresReplace bool) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environment_id"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	if d.EnvironmentId == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Missing Environment ID",
+			"Expected `environment_id` to be set on this resource, or `default_environment_id` to be set on the provider.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("environment_id"), types.StringValue(d.EnvironmentId))...)
+
+	if !requiresReplace || req.State.Raw.IsNull() {
+		return
+	}
+	var state types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_id"), &state)...)
+	if !state.IsNull() && state.ValueString() != d.EnvironmentId {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("environment_id"))
+	}
+}
+
+// mergeTags returns default_tags overlaid with the resource's own tags, which take precedence.
+// The result is unknown if any of the resource's tags are, and null if there are no tags at all.
+func (d ProviderDefaults) mergeTags(tags types.Map) types.Map {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+
+	merged := make(map[string]string, len(d.Tags)+len(tags.Elements()))
+	for k, v := range d.Tags {
+		merged[k] = v
+	}
+	for k, v := range tags.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsUnknown() {
+			return types.MapUnknown(types.StringType)
+		}
+		merged[k] = s.ValueString()
+	}
+
+	return tagsToMap(merged)
+}
+
+// tagsToMap converts tags returned by the Altitude API into a map value, which is null when there are none.
+func tagsToMap(tags map[string]string) types.Map {
+	if len(tags) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]types.String, len(tags))
+	for k, v := range tags {
+		elements[k] = types.StringValue(v)
+	}
+	m, _ := types.MapValueFrom(context.Background(), types.StringType, elements)
+	return m
+}
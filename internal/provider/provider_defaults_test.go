@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviderDefaultsMergeTags(t *testing.T) {
+	tags := func(elements map[string]string) types.Map {
+		values := make(map[string]attr.Value, len(elements))
+		for k, v := range elements {
+			values[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, values)
+	}
+
+	testCases := map[string]struct {
+		defaults map[string]string
+		tags     types.Map
+		expected types.Map
+	}{
+		"none": {
+			tags:     types.MapNull(types.StringType),
+			expected: types.MapNull(types.StringType),
+		},
+		"defaults-only": {
+			defaults: map[string]string{"team": "platform"},
+			tags:     types.MapNull(types.StringType),
+			expected: tags(map[string]string{"team": "platform"}),
+		},
+		"resource-only": {
+			tags:     tags(map[string]string{"cost_centre": "123"}),
+			expected: tags(map[string]string{"cost_centre": "123"}),
+		},
+		"resource-overrides-default": {
+			defaults: map[string]string{"team": "platform", "cost_centre": "100"},
+			tags:     tags(map[string]string{"cost_centre": "123"}),
+			expected: tags(map[string]string{"team": "platform", "cost_centre": "123"}),
+		},
+		"unknown": {
+			defaults: map[string]string{"team": "platform"},
+			tags:     types.MapUnknown(types.StringType),
+			expected: types.MapUnknown(types.StringType),
+		},
+		"unknown-element": {
+			tags:     types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringUnknown()}),
+			expected: types.MapUnknown(types.StringType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			merged := ProviderDefaults{Tags: testCase.defaults}.mergeTags(testCase.tags)
+
+			if !merged.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, merged)
+			}
+		})
+	}
+}
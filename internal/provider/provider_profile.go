@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"terraform-provider-altitude/internal/provider/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// defaultProfile is used when no profile is selected, if the credentials file has one.
+const defaultProfile = "default"
+
+// profileKeys are the settings a profile may hold.
+var profileKeys = []string{"client_id", "client_secret", "mode", "base_url", "audience", "issuer"}
+
+// credentialsProfile holds the settings of a named profile in the shared credentials file. These are used
+// for any setting which is not configured on the provider or in the environment.
+type credentialsProfile struct {
+	clientId     string
+	clientSecret string
+	mode         string
+	endpoints    client.Endpoints
+}
+
+// credentialsFilePath returns the path of the shared credentials file, which is ~/.altitude/credentials
+// unless overridden by ALTITUDE_CREDENTIALS_FILE.
+func credentialsFilePath() (string, error) {
+	if p := os.Getenv("ALTITUDE_CREDENTIALS_FILE"); p != "" {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".altitude", "credentials"), nil
+}
+
+// loadProfile reads the named profile from the shared credentials file. When name is empty the default
+// profile is used if it exists, and a file which is missing or cannot be read is not an error.
+func loadProfile(name string) (credentialsProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	selected := name != ""
+	if !selected {
+		name = defaultProfile
+	}
+
+	filePath, err := credentialsFilePath()
+	if err != nil {
+		if selected {
+			diags.AddAttributeError(
+				path.Root("profile"),
+				"Unable to Find Altitude Credentials File",
+				fmt.Sprintf("The profile %q was selected but the home directory could not be found: %s. "+
+					"Set the ALTITUDE_CREDENTIALS_FILE environment variable to the path of the credentials file.", name, err),
+			)
+		}
+		return credentialsProfile{}, diags
+	}
+
+	file, err := os.Open(filePath)
+	if errors.Is(err, fs.ErrNotExist) && !selected {
+		return credentialsProfile{}, diags
+	}
+	if err != nil && !selected {
+		diags.AddWarning(
+			"Unable to Read Altitude Credentials File",
+			fmt.Sprintf("The credentials file could not be read, so its default profile has not been used: %s", err),
+		)
+		return credentialsProfile{}, diags
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Read Altitude Credentials File",
+			fmt.Sprintf("The profile %q was selected but the credentials file could not be read: %s", name, err),
+		)
+		return credentialsProfile{}, diags
+	}
+	defer file.Close()
+
+	profiles, err := parseProfiles(file)
+	if err != nil && !selected {
+		diags.AddWarning(
+			"Invalid Altitude Credentials File",
+			fmt.Sprintf("The credentials file %s could not be parsed, so its default profile has not been used: %s", filePath, err),
+		)
+		return credentialsProfile{}, diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Invalid Altitude Credentials File",
+			fmt.Sprintf("The credentials file %s could not be parsed: %s", filePath, err),
+		)
+		return credentialsProfile{}, diags
+	}
+
+	settings, ok := profiles[name]
+	if !ok {
+		if selected {
+			names := make([]string, 0, len(profiles))
+			for n := range profiles {
+				names = append(names, n)
+			}
+			slices.Sort(names)
+			diags.AddAttributeError(
+				path.Root("profile"),
+				"Altitude Profile Not Found",
+				fmt.Sprintf("The profile %q does not exist in the credentials file %s. The available profiles are: %s.",
+					name, filePath, strings.Join(names, ", ")),
+			)
+		}
+		return credentialsProfile{}, diags
+	}
+
+	profile := credentialsProfile{
+		clientId:     settings["client_id"],
+		clientSecret: settings["client_secret"],
+		mode:         settings["mode"],
+		endpoints: client.Endpoints{
+			BaseUrl:  settings["base_url"],
+			Audience: settings["audience"],
+			Issuer:   settings["issuer"],
+		},
+	}
+	if mode := client.Mode(profile.mode); profile.mode != "" && !mode.IsValid() {
+		detail := fmt.Sprintf("The profile %q in the credentials file %s has the mode %q, which must be either `Production`, `UAT` or `Local`.",
+			name, filePath, profile.mode)
+		if !selected {
+			diags.AddWarning("Invalid Altitude Profile", detail+" The default profile has not been used.")
+			return credentialsProfile{}, diags
+		}
+		diags.AddError("Invalid Altitude Profile", detail)
+	}
+	return profile, diags
+}
+
+// parseProfiles parses an INI file of named profiles, such as:
+//
+//	[default]
+//	client_id     = abc
+//	client_secret = def
+//	mode          = Production
+//
+// Blank lines and lines starting with # or ; are ignored.
+func parseProfiles(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current string
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") {
+				return nil, fmt.Errorf("line %d: profile names must be enclosed in square brackets", line)
+			}
+			current = strings.TrimSpace(text[1 : len(text)-1])
+			if current == "" {
+				return nil, fmt.Errorf("line %d: profile name must not be empty", line)
+			}
+			if _, ok := profiles[current]; ok {
+				return nil, fmt.Errorf("line %d: profile %q is defined more than once", line, current)
+			}
+			profiles[current] = make(map[string]string)
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected a profile name or a key = value pair", line)
+		}
+		if current == "" {
+			return nil, fmt.Errorf("line %d: settings must follow a profile name such as [default]", line)
+		}
+		key = strings.TrimSpace(key)
+		if !slices.Contains(profileKeys, key) {
+			return nil, fmt.Errorf("line %d: unknown setting %q, expected one of %s", line, key, strings.Join(profileKeys, ", "))
+		}
+		profiles[current][key] = strings.TrimSpace(value)
+	}
+
+	return profiles, scanner.Err()
+}
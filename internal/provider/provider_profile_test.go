@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"terraform-provider-altitude/internal/provider/client"
+	"testing"
+)
+
+func TestParseProfiles(t *testing.T) {
+	testCases := map[string]struct {
+		content  string
+		expected map[string]map[string]string
+		isError  bool
+	}{
+		"profiles": {
+			content: `
+# Shared by the platform team
+[default]
+client_id     = abc
+client_secret = def==
+
+[staging]
+; Private deployment
+mode     = UAT
+base_url = https://altitude.internal.example.com
+`,
+			expected: map[string]map[string]string{
+				"default": {"client_id": "abc", "client_secret": "def=="},
+				"staging": {"mode": "UAT", "base_url": "https://altitude.internal.example.com"},
+			},
+		},
+		"setting-outside-profile": {
+			content: "client_id = abc\n[default]\n",
+			isError: true,
+		},
+		"unknown-setting": {
+			content: "[default]\nclient_ids = abc\n",
+			isError: true,
+		},
+		"duplicate-profile": {
+			content: "[default]\n[default]\n",
+			isError: true,
+		},
+		"unterminated-profile-name": {
+			content: "[default\n",
+			isError: true,
+		},
+		"missing-value": {
+			content: "[default]\nclient_id\n",
+			isError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			profiles, err := parseProfiles(strings.NewReader(tc.content))
+
+			if (err != nil) != tc.isError {
+				t.Fatalf("expected error=%v, got %v", tc.isError, err)
+			}
+			if !tc.isError && !reflect.DeepEqual(profiles, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, profiles)
+			}
+		})
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	content := "[default]\nclient_id = abc\nclient_secret = def\n\n[private]\nmode = Local\nbase_url = http://localhost:9090\n\n[invalid]\nmode = Staging\n"
+	if err := os.WriteFile(credentialsFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	invalidDefaultFile := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(invalidDefaultFile, []byte("[default]\nclient_id = abc\nmode = Staging\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	malformedFile := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(malformedFile, []byte("[default\nclient_id = abc\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		file      string
+		profile   string
+		expected  credentialsProfile
+		isError   bool
+		isWarning bool
+	}{
+		"default": {
+			file:     credentialsFile,
+			expected: credentialsProfile{clientId: "abc", clientSecret: "def"},
+		},
+		"selected": {
+			file:     credentialsFile,
+			profile:  "private",
+			expected: credentialsProfile{mode: "Local", endpoints: client.Endpoints{BaseUrl: "http://localhost:9090"}},
+		},
+		"missing-profile": {
+			file:    credentialsFile,
+			profile: "production",
+			isError: true,
+		},
+		"invalid-mode": {
+			file:    credentialsFile,
+			profile: "invalid",
+			isError: true,
+		},
+		"missing-file": {
+			file: filepath.Join(t.TempDir(), "credentials"),
+		},
+		"missing-file-with-profile": {
+			file:    filepath.Join(t.TempDir(), "credentials"),
+			profile: "private",
+			isError: true,
+		},
+		"invalid-default-mode": {
+			file:      invalidDefaultFile,
+			isWarning: true,
+		},
+		"invalid-default-mode-selected": {
+			file:    invalidDefaultFile,
+			profile: "default",
+			isError: true,
+		},
+		"malformed-file": {
+			file:      malformedFile,
+			isWarning: true,
+		},
+		"malformed-file-with-profile": {
+			file:    malformedFile,
+			profile: "default",
+			isError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("ALTITUDE_CREDENTIALS_FILE", tc.file)
+
+			profile, diags := loadProfile(tc.profile)
+
+			if diags.HasError() != tc.isError {
+				t.Fatalf("expected error=%v, got diagnostics %v", tc.isError, diags)
+			}
+			if (diags.WarningsCount() > 0) != tc.isWarning {
+				t.Errorf("expected warning=%v, got diagnostics %v", tc.isWarning, diags)
+			}
+			if !tc.isError && profile != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, profile)
+			}
+		})
+	}
+}
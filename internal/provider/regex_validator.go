@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexValidator{}
+
+// regexValidator validates that a string is a regular expression which can be compiled.
+type regexValidator struct{}
+
+func (v regexValidator) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("The value %q is not a valid regular expression: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// renderedPayloadAttribute is the schema of the computed `rendered_payload` attribute, which shows the JSON
+// document sent to the Altitude API so that its changes appear in plans.
+func renderedPayloadAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed: true,
+		MarkdownDescription: "The JSON document which is sent to the Altitude API, with any secrets redacted. Lists whose order is not " +
+			"significant are sorted, with header names lowercased. This is unknown during planning until all of the values it depends on are known.",
+	}
+}
+
+// renderPayload encodes a DTO as it is sent to the Altitude API.
+func renderPayload(dto any) types.String {
+	body, err := json.Marshal(dto)
+	if err != nil {
+		return types.StringUnknown()
+	}
+	return types.StringValue(string(body))
+}
+
+// planAttributesKnown reports whether the named top level attributes of a plan are fully known, and so
+// whether the payload built from them can be rendered.
+func planAttributesKnown(plan tfsdk.Plan, names ...string) bool {
+	for _, name := range names {
+		v, _, err := tftypes.WalkAttributePath(plan.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			return false
+		}
+		if value, ok := v.(tftypes.Value); !ok || !value.IsFullyKnown() {
+			return false
+		}
+	}
+	return true
+}
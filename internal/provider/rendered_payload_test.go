@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-altitude/internal/provider/client"
+)
+
+func TestMTEConfigRenderPayloadCanonical(t *testing.T) {
+	planned := &client.MTEConfigDto{
+		Routes: []client.RouteDto{{Host: "www.thgaltitude.com", Path: "/"}},
+		Cache:  []client.CacheDto{{Keys: &client.CacheKeyDto{Header: []string{"Accept-Language", "Accept"}, Cookie: []string{"b", "a"}}}},
+	}
+	returned := &client.MTEConfigDto{
+		Routes: []client.RouteDto{{Host: "www.thgaltitude.com", Path: "/"}},
+		Cache:  []client.CacheDto{{Keys: &client.CacheKeyDto{Header: []string{"accept", "accept-language"}, Cookie: []string{"a", "b"}}}},
+	}
+
+	plannedPayload := (&MTEConfigResourceModel{Config: transformToResourceModel(planned)}).renderPayload()
+	returnedPayload := (&MTEConfigResourceModel{Config: transformToResourceModel(returned)}).renderPayload()
+
+	if !plannedPayload.Equal(returnedPayload) {
+		t.Errorf("expected semantically equal configs to render identically, got %s and %s", plannedPayload, returnedPayload)
+	}
+
+	model := transformToResourceModel(planned)
+	(&MTEConfigResourceModel{Config: model}).renderPayload()
+	if got := model.Cache[0].Keys.Headers.ValueStrings(); got[0] != "Accept-Language" {
+		t.Errorf("expected rendering to leave the config unchanged, got %v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"strings"
+)
+
+// shadowedRoutes returns each pair of route indexes where the first route matches every request
+// the second, later route would, leaving the second unreachable. Routes match on path prefix, so an
+// earlier route covers a later one when its path is a prefix of the later path. Routes containing
+// unknown values are skipped as they cannot be analysed at plan time.
+func shadowedRoutes(routes []RouteModel) [][2]int {
+	var shadowed [][2]int
+	for j := range routes {
+		if routes[j].Path.IsUnknown() {
+			continue
+		}
+		for i := 0; i < j; i++ {
+			if routes[i].Path.IsUnknown() || !strings.HasPrefix(routes[j].Path.ValueString(), routes[i].Path.ValueString()) {
+				continue
+			}
+			if routeMatchCovers(routes[i].Match, routes[j].Match) {
+				shadowed = append(shadowed, [2]int{i, j})
+				break
+			}
+		}
+	}
+	return shadowed
+}
+
+// routeMatchCovers reports whether every request satisfying b also satisfies a. This is the
+// case when each condition of a is also a condition of b, as all conditions must be met.
+func routeMatchCovers(a, b *RouteMatchModel) bool {
+	if a == nil {
+		return true
+	}
+	if b == nil {
+		return a.isEmpty()
+	}
+	if a.Methods.IsUnknown() || b.Methods.IsUnknown() {
+		return false
+	}
+
+	if methods := a.Methods.ValueStrings(); len(methods) > 0 {
+		other := b.Methods.ValueStrings()
+		if len(other) == 0 {
+			return false
+		}
+		for _, m := range other {
+			if !containsFold(methods, m) {
+				return false
+			}
+		}
+	}
+
+	return predicatesCovered(a.Headers, b.Headers, true) &&
+		predicatesCovered(a.Cookies, b.Cookies, false) &&
+		predicatesCovered(a.QueryParameters, b.QueryParameters, false)
+}
+
+// predicatesCovered reports whether every predicate in a also appears in b with the same pattern.
+func predicatesCovered(a, b []MatchPredicateModel, ignoreNameCase bool) bool {
+	for _, p := range a {
+		if p.Name.IsUnknown() || p.Pattern.IsUnknown() {
+			return false
+		}
+		found := false
+		for _, o := range b {
+			sameName := o.Name.ValueString() == p.Name.ValueString() ||
+				(ignoreNameCase && strings.EqualFold(o.Name.ValueString(), p.Name.ValueString()))
+			if sameName && o.Pattern.Equal(p.Pattern) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
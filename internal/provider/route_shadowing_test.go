@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShadowedRoutes(t *testing.T) {
+	route := func(p string, m *RouteMatchModel) RouteModel {
+		return RouteModel{Path: types.StringValue(p), Match: m}
+	}
+	methods := func(m ...string) *RouteMatchModel {
+		return &RouteMatchModel{Methods: NewUnorderedListValue(m, false)}
+	}
+	header := func(name, pattern string) *RouteMatchModel {
+		return &RouteMatchModel{
+			Methods: NewUnorderedListValueOrNull(nil, false),
+			Headers: []MatchPredicateModel{{Name: types.StringValue(name), Pattern: types.StringValue(pattern)}},
+		}
+	}
+
+	testCases := map[string]struct {
+		routes   []RouteModel
+		expected [][2]int
+	}{
+		"different-paths": {
+			routes: []RouteModel{route("/api", nil), route("/docs", nil)},
+		},
+		"duplicate-paths": {
+			routes:   []RouteModel{route("/api", nil), route("/api", nil)},
+			expected: [][2]int{{0, 1}},
+		},
+		"unconditional-before-conditional": {
+			routes:   []RouteModel{route("/api", nil), route("/api", methods("POST"))},
+			expected: [][2]int{{0, 1}},
+		},
+		"conditional-before-unconditional": {
+			routes: []RouteModel{route("/api", methods("POST")), route("/api", nil)},
+		},
+		"wider-methods-first": {
+			routes:   []RouteModel{route("/api", methods("POST", "PUT")), route("/api", methods("post"))},
+			expected: [][2]int{{0, 1}},
+		},
+		"narrower-methods-first": {
+			routes: []RouteModel{route("/api", methods("POST")), route("/api", methods("POST", "PUT"))},
+		},
+		"same-header-any-case": {
+			routes:   []RouteModel{route("/api", header("X-App-Version", "2")), route("/api", header("x-app-version", "2"))},
+			expected: [][2]int{{0, 1}},
+		},
+		"different-header-pattern": {
+			routes: []RouteModel{route("/api", header("x-app-version", "2")), route("/api", header("x-app-version", "3"))},
+		},
+		"prefix-before-longer-path": {
+			routes:   []RouteModel{route("/api", nil), route("/api/v1", nil)},
+			expected: [][2]int{{0, 1}},
+		},
+		"prefix-with-wider-match-first": {
+			routes:   []RouteModel{route("/api", methods("POST", "PUT")), route("/api/v1", methods("POST"))},
+			expected: [][2]int{{0, 1}},
+		},
+		"prefix-with-narrower-match-first": {
+			routes: []RouteModel{route("/api", methods("POST")), route("/api/v1", nil)},
+		},
+		"longer-path-before-prefix": {
+			routes: []RouteModel{route("/api/v1", nil), route("/api", nil)},
+		},
+		"unknown-path": {
+			routes: []RouteModel{{Path: types.StringUnknown()}, {Path: types.StringUnknown()}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			shadowed := shadowedRoutes(testCase.routes)
+
+			if !slices.Equal(shadowed, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, shadowed)
+			}
+		})
+	}
+}
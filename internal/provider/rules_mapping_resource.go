@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"terraform-provider-altitude/internal/provider/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MTERulesMappingResource{}
+var _ resource.ResourceWithModifyPlan = &MTERulesMappingResource{}
 var _ resource.ResourceWithImportState = &MTERulesMappingResource{}
 
 func NewMTERulesMappingResource() resource.Resource {
@@ -26,8 +29,11 @@ type MTERulesMappingResource struct {
 }
 
 type MTERulesMappingResourceModel struct {
-	RulesId types.String `tfsdk:"rules_id"`
-	Domain  types.String `tfsdk:"domain"`
+	RulesId         types.String   `tfsdk:"rules_id"`
+	Domain          types.String   `tfsdk:"domain"`
+	AdoptExisting   types.Bool     `tfsdk:"adopt_existing"`
+	RenderedPayload types.String   `tfsdk:"rendered_payload"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata implements resource.Resource.
@@ -52,6 +58,23 @@ func (m *MTERulesMappingResource) Configure(ctx context.Context, req resource.Co
 	m.client = resourceData.client
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (m *MTERulesMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !planAttributesKnown(req.Plan, "rules_id", "domain") {
+		return
+	}
+
+	var data MTERulesMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rendered_payload"), renderPayload(data.transformToApiRequestBody()))...)
+}
+
 // Schema implements resource.Resource.
 func (m *MTERulesMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -70,12 +93,53 @@ func (m *MTERulesMappingResource) Schema(ctx context.Context, req resource.Schem
 				Required:            true,
 				MarkdownDescription: "The rule group ID the domain should be associated with.",
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "A boolean specifying whether an existing rules mapping for this domain should be taken over on creation " +
+					"instead of failing. The existing mapping will be updated to point at `rules_id`.",
+			},
+			"rendered_payload": renderedPayloadAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 // ImportState implements resource.ResourceWithImportState.
 func (m *MTERulesMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	validateImportDomain(req.ID, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := m.client.ReadMteRulesMapping(ctx, client.ReadMteRulesMappingInput{Domain: req.ID})
+
+	var notFoundErr *client.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		resp.Diagnostics.AddError(
+			"Cannot Import Non-Existent MTE Rules Mapping",
+			fmt.Sprintf("The domain %q has no rules mapping to import. Check the domain is correct, or remove the import "+
+				"and let Terraform create the mapping instead.", req.ID),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while checking the rules mapping exists:\n\n"+
+				err.Error(),
+		)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
 }
 
@@ -89,12 +153,28 @@ func (m *MTERulesMappingResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	err := m.client.CreateMteRulesMapping(
+		ctx,
 		client.CreateMteRulesMappingInput{
 			Config: data.transformToApiRequestBody(),
 		},
 	)
 
+	var conflictErr *client.ConflictError
+	if errors.As(err, &conflictErr) && data.AdoptExisting.ValueBool() {
+		err = m.adoptExistingMapping(ctx, &data, resp)
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create MTE Rules mapping",
@@ -104,6 +184,7 @@ func (m *MTERulesMappingResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	data.RenderedPayload = renderPayload(data.transformToApiRequestBody())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -117,7 +198,18 @@ func (m *MTERulesMappingResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := m.client.DeleteMteRulesMapping(
+		ctx,
 		client.DeleteMteRulesMappingInput{
 			Domain: data.Domain.ValueString(),
 		},
@@ -140,7 +232,18 @@ func (m *MTERulesMappingResource) Read(ctx context.Context, req resource.ReadReq
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	rulesId, err := m.client.ReadMteRulesMapping(
+		ctx,
 		client.ReadMteRulesMappingInput{
 			Domain: data.Domain.ValueString(),
 		},
@@ -152,6 +255,7 @@ func (m *MTERulesMappingResource) Read(ctx context.Context, req resource.ReadReq
 	}
 	data.RulesId = types.StringValue(rulesId)
 
+	data.RenderedPayload = renderPayload(data.transformToApiRequestBody())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -165,7 +269,18 @@ func (m *MTERulesMappingResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	err := m.client.UpdateMteRulesMapping(
+		ctx,
 		client.UpdateMteRulesMappingInput{
 			Config: plan.transformToApiRequestBody(),
 		},
@@ -180,9 +295,41 @@ func (m *MTERulesMappingResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	plan.RenderedPayload = renderPayload(plan.transformToApiRequestBody())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// adoptExistingMapping takes over a rules mapping which already exists in Altitude by
+// updating it to the planned rule group.
+func (m *MTERulesMappingResource) adoptExistingMapping(ctx context.Context, data *MTERulesMappingResourceModel, resp *resource.CreateResponse) error {
+	existingRulesId, err := m.client.ReadMteRulesMapping(
+		ctx,
+		client.ReadMteRulesMappingInput{
+			Domain: data.Domain.ValueString(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = m.client.UpdateMteRulesMapping(
+		ctx,
+		client.UpdateMteRulesMappingInput{
+			Config: data.transformToApiRequestBody(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Adopted existing MTE rules mapping",
+		fmt.Sprintf("The domain %s was already associated with the rule group %s. This mapping has been taken over by Terraform and is now associated with the rule group %s.",
+			data.Domain.ValueString(), existingRulesId, data.RulesId.ValueString()),
+	)
+	return nil
+}
+
 func (m *MTERulesMappingResourceModel) transformToApiRequestBody() client.MTERulesMappingDto {
 	return client.MTERulesMappingDto{
 		RulesId: m.RulesId.ValueString(),
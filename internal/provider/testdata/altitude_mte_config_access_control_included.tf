@@ -0,0 +1,30 @@
+resource "altitude_mte_config" "access-control-test" {
+  config = {
+    routes = [
+      {
+        host                 = "%s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      },
+      {
+        host                 = "docs.thgaltitude.com"
+        path                 = "/docs"
+        enable_ssl           = true
+        preserve_path_prefix = false
+        access_control = {
+          country_allowlist = ["GB", "IE"]
+        }
+      }
+    ]
+    access_control = {
+      ip_allowlist = ["192.0.2.0/24", "2001:db8::/32"]
+      ip_denylist  = ["198.51.100.0/24"]
+      bypass_token = {
+        header = "X-Bypass-Token"
+        tokens = ["foobar"]
+      }
+    }
+  }
+  environment_id = "%s"
+}
@@ -0,0 +1,30 @@
+resource "altitude_mte_config" "cache-field-test" {
+  config = {
+    routes = [
+      {
+        host                 = "%s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+        shield_location      = "London"
+      }
+    ]
+    cache = [
+      {
+        path_rules = {
+          any_match  = ["/c/**"]
+          none_match = []
+        }
+        query_parameters = {
+          exclude = ["utm_*", "gclid"]
+          sort    = true
+        }
+        bypass = {
+          cookies = ["session"]
+        }
+        ttl_seconds = 100
+      }
+    ]
+  }
+  environment_id = "%s"
+}
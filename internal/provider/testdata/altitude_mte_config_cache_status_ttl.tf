@@ -0,0 +1,25 @@
+resource "altitude_mte_config" "cache-field-test" {
+  config = {
+    routes = [
+      {
+        host                 = "%s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+        shield_location      = "London"
+      }
+    ]
+    cache = [
+      {
+        ttl_seconds = 3600
+        status_ttl_seconds = {
+          "404" = 60
+        }
+        stale_while_revalidate_seconds = 30
+        stale_if_error_seconds         = 86400
+        respect_origin_headers         = false
+      }
+    ]
+  }
+  environment_id = "%s"
+}
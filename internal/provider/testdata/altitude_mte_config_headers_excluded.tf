@@ -0,0 +1,13 @@
+resource "altitude_mte_config" "headers-test" {
+  config = {
+    routes = [
+      {
+        host                 = "%s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+  }
+  environment_id = "%s"
+}
@@ -0,0 +1,32 @@
+resource "altitude_mte_config" "headers-test" {
+  config = {
+    routes = [
+      {
+        host                 = "%s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+    headers = [
+      {
+        response = {
+          set = {
+            "Strict-Transport-Security" = "max-age=31536000; includeSubDomains"
+            "X-Frame-Options"           = "DENY"
+          }
+          remove = ["Server"]
+        }
+      },
+      {
+        route = "/test"
+        request = {
+          add = {
+            "X-Forwarded-Route" = "test"
+          }
+        }
+      }
+    ]
+  }
+  environment_id = "%s"
+}
@@ -0,0 +1,17 @@
+resource "altitude_mte_config" "origin-host-override-test" {
+  config = {
+    routes = [
+      {
+        host                 = "%s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+        override_host        = "origin.thgaltitude.com"
+        sni_hostname         = "origin.thgaltitude.com"
+        port                 = 8443
+        verify_certificate   = false
+      }
+    ]
+  }
+  environment_id = "%s"
+}
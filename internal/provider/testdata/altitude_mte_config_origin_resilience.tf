@@ -0,0 +1,26 @@
+resource "altitude_mte_config" "origin-resilience-test" {
+  config = {
+    routes = [
+      {
+        host                     = "%s"
+        path                     = "/test"
+        enable_ssl               = true
+        preserve_path_prefix     = true
+        connect_timeout_ms       = 2000
+        first_byte_timeout_ms    = 15000
+        between_bytes_timeout_ms = 10000
+        retry_attempts           = 2
+        health_check = {
+          path                = "/healthcheck"
+          interval_seconds    = 30
+          timeout_ms          = 5000
+          expected_status     = 200
+          healthy_threshold   = 2
+          unhealthy_threshold = 3
+          fallback_hosts      = ["backup.thgaltitude.com"]
+        }
+      }
+    ]
+  }
+  environment_id = "%s"
+}
@@ -0,0 +1,23 @@
+provider "altitude" {
+  default_environment_id = "%[2]s"
+  default_tags = {
+    team        = "platform"
+    cost_centre = "100"
+  }
+}
+
+resource "altitude_mte_config" "provider-defaults-test" {
+  config = {
+    routes = [
+      {
+        host                 = "%[1]s"
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+  }
+  tags = {
+    cost_centre = "123"
+  }
+}
@@ -0,0 +1,28 @@
+resource "altitude_mte_config" "route-match-test" {
+  config = {
+    routes = [
+      {
+        host                 = "api-v2.thgaltitude.com"
+        path                 = "/api"
+        enable_ssl           = true
+        preserve_path_prefix = true
+        match = {
+          methods = ["POST", "PUT"]
+          headers = [
+            {
+              name    = "x-app-version"
+              pattern = "2(\\..*)?"
+            }
+          ]
+        }
+      },
+      {
+        host                 = "%s"
+        path                 = "/api"
+        enable_ssl           = true
+        preserve_path_prefix = true
+      }
+    ]
+  }
+  environment_id = "%s"
+}
@@ -0,0 +1,30 @@
+resource "altitude_mte_config" "weighted-origins-test" {
+  config = {
+    routes = [
+      {
+        path                 = "/test"
+        enable_ssl           = true
+        preserve_path_prefix = true
+        origins = [
+          {
+            host   = "%s"
+            weight = 90
+          },
+          {
+            host   = "green.thgaltitude.com"
+            weight = 10
+          }
+        ]
+        sticky_cookie = "altitude-origin"
+        origin_overrides = [
+          {
+            header = "x-canary"
+            value  = "1"
+            host   = "green.thgaltitude.com"
+          }
+        ]
+      }
+    ]
+  }
+  environment_id = "%s"
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.ListTypable                    = UnorderedListType{}
+	_ basetypes.ListValuableWithSemanticEquals = UnorderedListValue{}
+)
+
+// UnorderedListType is a list of strings where the ordering of the elements has no meaning
+// to the Altitude API. Two values holding the same elements in a different order are
+// semantically equal, so the ordering written by the user is kept in state even when the
+// API returns the elements reordered. When ignoreCase is set, elements are also compared
+// case-insensitively, which suits lists of header names.
+type UnorderedListType struct {
+	basetypes.ListType
+	ignoreCase bool
+}
+
+func NewUnorderedListType(ignoreCase bool) UnorderedListType {
+	return UnorderedListType{
+		ListType:   basetypes.ListType{ElemType: types.StringType},
+		ignoreCase: ignoreCase,
+	}
+}
+
+func (t UnorderedListType) Equal(o attr.Type) bool {
+	other, ok := o.(UnorderedListType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ignoreCase == other.ignoreCase && t.ListType.Equal(other.ListType)
+}
+
+func (t UnorderedListType) String() string {
+	return fmt.Sprintf("UnorderedListType(ignoreCase=%t)", t.ignoreCase)
+}
+
+func (t UnorderedListType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return UnorderedListValue{
+		ListValue:  in,
+		ignoreCase: t.ignoreCase,
+	}, nil
+}
+
+func (t UnorderedListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromList(ctx, listValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+func (t UnorderedListType) ValueType(ctx context.Context) attr.Value {
+	return UnorderedListValue{
+		ignoreCase: t.ignoreCase,
+	}
+}
+
+type UnorderedListValue struct {
+	basetypes.ListValue
+	ignoreCase bool
+}
+
+// NewUnorderedListValue creates a known list holding the given strings, in order.
+func NewUnorderedListValue(values []string, ignoreCase bool) UnorderedListValue {
+	var elements = make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return UnorderedListValue{
+		ListValue:  types.ListValueMust(types.StringType, elements),
+		ignoreCase: ignoreCase,
+	}
+}
+
+// NewUnorderedListValueOrNull creates a list holding the given strings, or a null list
+// when values is nil.
+func NewUnorderedListValueOrNull(values []string, ignoreCase bool) UnorderedListValue {
+	if values == nil {
+		return UnorderedListValue{
+			ListValue:  types.ListNull(types.StringType),
+			ignoreCase: ignoreCase,
+		}
+	}
+	return NewUnorderedListValue(values, ignoreCase)
+}
+
+// listValue returns the underlying list, treating the zero value as a null list of strings.
+func (v UnorderedListValue) listValue() basetypes.ListValue {
+	if v.ListValue.ElementType(context.Background()) == nil {
+		return types.ListNull(types.StringType)
+	}
+	return v.ListValue
+}
+
+func (v UnorderedListValue) Equal(o attr.Value) bool {
+	other, ok := o.(UnorderedListValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.ignoreCase == other.ignoreCase && v.listValue().Equal(other.listValue())
+}
+
+func (v UnorderedListValue) Type(ctx context.Context) attr.Type {
+	return NewUnorderedListType(v.ignoreCase)
+}
+
+func (v UnorderedListValue) ElementType(ctx context.Context) attr.Type {
+	return types.StringType
+}
+
+func (v UnorderedListValue) ToListValue(ctx context.Context) (basetypes.ListValue, diag.Diagnostics) {
+	return v.listValue(), nil
+}
+
+func (v UnorderedListValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return v.listValue().ToTerraformValue(ctx)
+}
+
+// ListSemanticEquals implements basetypes.ListValuableWithSemanticEquals.
+func (v UnorderedListValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UnorderedListValue)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return false, diags
+	}
+
+	return slices.Equal(v.normalised(), newValue.normalised()), diags
+}
+
+// ValueStrings returns the elements of the list as strings. The result is never nil, so
+// an empty list is sent to the API as `[]` rather than `null`.
+func (v UnorderedListValue) ValueStrings() []string {
+	var elements = v.Elements()
+	var values = make([]string, len(elements))
+	for i, e := range elements {
+		if s, ok := e.(types.String); ok {
+			values[i] = s.ValueString()
+		}
+	}
+	return values
+}
+
+func (v UnorderedListValue) normalised() []string {
+	values := v.ValueStrings()
+	if v.ignoreCase {
+		for i, s := range values {
+			values[i] = strings.ToLower(s)
+		}
+	}
+	slices.Sort(values)
+	return values
+}
+
+// canonical returns the list with its elements sorted, and lowercased when case is ignored, so that
+// semantically equal values are also equal. Null, unknown and partially unknown lists are returned as is.
+func (v UnorderedListValue) canonical() UnorderedListValue {
+	if v.IsNull() || v.IsUnknown() {
+		return v
+	}
+	for _, e := range v.Elements() {
+		if e.IsUnknown() || e.IsNull() {
+			return v
+		}
+	}
+	return NewUnorderedListValue(v.normalised(), v.ignoreCase)
+}
+
+// canonicalUnorderedLists returns a copy of model with every UnorderedListValue within it made canonical.
+func canonicalUnorderedLists[T any](model T) T {
+	return canonicalCopy(reflect.ValueOf(model)).Interface().(T)
+}
+
+func canonicalCopy(v reflect.Value) reflect.Value {
+	if list, ok := v.Interface().(UnorderedListValue); ok {
+		return reflect.ValueOf(list.canonical())
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(canonicalCopy(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(canonicalCopy(v.Field(i)))
+			}
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(canonicalCopy(v.Index(i)))
+		}
+		return c
+	}
+	return v
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnorderedListValueSemanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		prior      []string
+		new        []string
+		ignoreCase bool
+		expected   bool
+	}{
+		"identical": {
+			prior:    []string{"foo", "bar"},
+			new:      []string{"foo", "bar"},
+			expected: true,
+		},
+		"reordered": {
+			prior:    []string{"foo", "bar"},
+			new:      []string{"bar", "foo"},
+			expected: true,
+		},
+		"different-case-case-sensitive": {
+			prior:    []string{"X-Header"},
+			new:      []string{"x-header"},
+			expected: false,
+		},
+		"different-case-ignore-case": {
+			prior:      []string{"X-Header", "Accept"},
+			new:        []string{"accept", "x-header"},
+			ignoreCase: true,
+			expected:   true,
+		},
+		"different-elements": {
+			prior:    []string{"foo", "bar"},
+			new:      []string{"foo", "baz"},
+			expected: false,
+		},
+		"duplicate-elements": {
+			prior:    []string{"foo", "foo", "bar"},
+			new:      []string{"foo", "bar", "bar"},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			prior := NewUnorderedListValue(testCase.prior, testCase.ignoreCase)
+			new := NewUnorderedListValue(testCase.new, testCase.ignoreCase)
+
+			equal, diags := prior.ListSemanticEquals(context.Background(), new)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != testCase.expected {
+				t.Errorf("expected semantic equality to be %t, got %t", testCase.expected, equal)
+			}
+		})
+	}
+}
+
+func TestUnorderedListValueSemanticEqualsNull(t *testing.T) {
+	prior := NewUnorderedListValue([]string{"foo"}, false)
+	null := UnorderedListValue{ListValue: types.ListNull(types.StringType)}
+
+	equal, diags := prior.ListSemanticEquals(context.Background(), null)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if equal {
+		t.Error("expected a null list not to be semantically equal to a known list")
+	}
+}
+
+func TestUnorderedListValueZeroValue(t *testing.T) {
+	ctx := context.Background()
+	var v UnorderedListValue
+
+	if !v.IsNull() {
+		t.Error("expected the zero value to be null")
+	}
+	if _, err := v.ToTerraformValue(ctx); err != nil {
+		t.Errorf("unexpected error converting the zero value: %s", err)
+	}
+	if !v.Type(ctx).Equal(NewUnorderedListType(false)) {
+		t.Errorf("unexpected type %s", v.Type(ctx))
+	}
+	if !v.Equal(NewUnorderedListValueOrNull(nil, false)) {
+		t.Error("expected the zero value to equal a null list")
+	}
+}
+
+func TestUnorderedListValueEqual(t *testing.T) {
+	testCases := map[string]struct {
+		a        UnorderedListValue
+		b        UnorderedListValue
+		expected bool
+	}{
+		"identical": {
+			a:        NewUnorderedListValue([]string{"foo"}, true),
+			b:        NewUnorderedListValue([]string{"foo"}, true),
+			expected: true,
+		},
+		"different-case-mode": {
+			a:        NewUnorderedListValue([]string{"foo"}, true),
+			b:        NewUnorderedListValue([]string{"foo"}, false),
+			expected: false,
+		},
+		"reordered": {
+			a:        NewUnorderedListValue([]string{"foo", "bar"}, false),
+			b:        NewUnorderedListValue([]string{"bar", "foo"}, false),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.a.Equal(tc.b); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
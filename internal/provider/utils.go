@@ -1,6 +1,12 @@
 package provider
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
+
+// defaultTimeout is applied to resource operations which have no timeout configured.
+const defaultTimeout = 20 * time.Minute
 
 func AddAuthenticationToRequest(req *http.Request, apiKey string) {
 	bearer := "Bearer " + apiKey